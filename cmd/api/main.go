@@ -4,6 +4,7 @@ import (
 	"champi-maker/internal/application/service"
 	security "champi-maker/internal/infrastructure/auth"
 	"champi-maker/internal/infrastructure/config"
+	"champi-maker/internal/infrastructure/document"
 	"champi-maker/internal/infrastructure/messaging"
 	"champi-maker/internal/infrastructure/repository"
 	"champi-maker/internal/interfaces/handler"
@@ -27,8 +28,17 @@ func main() {
 	userRepo := repository.NewUserRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
 	statisticsRepo := repository.NewStatisticsRepositoryPg(pool)
+	cupStatisticsRepo := repository.NewCupStatisticsRepositoryPg(pool)
+	standingsSnapshotRepo := repository.NewStandingsSnapshotRepositoryPg(pool)
+	playerRepo := repository.NewPlayerRepositoryPg(pool)
+	championshipPlayerRepo := repository.NewChampionshipPlayerRepositoryPg(pool)
+	playerStatisticsRepo := repository.NewPlayerStatisticsRepositoryPg(pool)
+	suspensionRepo := repository.NewSuspensionRepositoryPg(pool)
+	lineupRepo := repository.NewLineupRepositoryPg(pool)
 
 	jwtSecret := config.GetRequiredEnv("JWT_SECRET")
 	jwtIssuer := config.GetRequiredEnv("JWT_ISSUER")
@@ -47,21 +57,36 @@ func main() {
 		log.Fatalf("Falha ao criar o MessagePublisher: %v", err)
 	}
 
+	matchSheetRenderer, err := document.NewHTMLMatchSheetRenderer()
+	if err != nil {
+		log.Fatalf("Falha ao carregar o modelo da súmula: %v", err)
+	}
+
 	userService := service.NewUserService(userRepo, tokenProvider)
 	teamService := service.NewTeamService(teamRepo, userRepo)
-	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo)
-	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, statisticsService)
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
+	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo, matchRepo, cupStatisticsRepo, standingsSnapshotRepo)
+	playerStatisticsService := service.NewPlayerStatisticsService(playerStatisticsRepo, championshipRepo, matchRepo, matchEventRepo, lineupRepo, playerRepo)
+	suspensionService := service.NewSuspensionService(suspensionRepo, championshipRepo, matchRepo, matchEventRepo)
+	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, matchEventRepo, statisticsService, playerStatisticsService, suspensionService)
+	matchEventService := service.NewMatchEventService(matchEventRepo, matchRepo, championshipPlayerRepo, lineupRepo, playerStatisticsService, suspensionService)
+	lineupService := service.NewLineupService(lineupRepo, matchRepo, championshipRepo, teamRepo, championshipPlayerRepo, suspensionService, matchSheetRenderer)
+	playerService := service.NewPlayerService(playerRepo, teamRepo, championshipRepo, championshipTeamRepo, championshipPlayerRepo)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
 
 	userHandler := handler.NewUserHandler(userService)
 	teamHandler := handler.NewTeamHandler(teamService)
 	statisticsHandler := handler.NewStatisticsHandler(statisticsService)
 	matchHandler := handler.NewMatchHandler(matchService)
 	championshipHandler := handler.NewChampionshipHandler(championshipService)
+	matchEventHandler := handler.NewMatchEventHandler(matchEventService)
+	playerHandler := handler.NewPlayerHandler(playerService)
+	playerStatisticsHandler := handler.NewPlayerStatisticsHandler(playerStatisticsService)
+	suspensionHandler := handler.NewSuspensionHandler(suspensionService)
+	lineupHandler := handler.NewLineupHandler(lineupService)
 
 	router := gin.Default()
 
-	routes.RegisterRoutes(router, userHandler, teamHandler, championshipHandler, matchHandler, statisticsHandler)
+	routes.RegisterRoutes(router, userHandler, teamHandler, championshipHandler, matchHandler, statisticsHandler, matchEventHandler, playerHandler, playerStatisticsHandler, suspensionHandler, lineupHandler)
 
 	go startMessageConsumer(matchService, rabbitConn)
 
@@ -0,0 +1,73 @@
+// Comando administrativo que recalcula as estatísticas de um campeonato e de seus jogadores a
+// partir das partidas concluídas. Uso: go run cmd/rebuild-statistics/main.go -championship <ID>
+package main
+
+import (
+	"champi-maker/internal/application/service"
+	"champi-maker/internal/infrastructure/config"
+	"champi-maker/internal/infrastructure/repository"
+	"context"
+	"flag"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func main() {
+	championshipIDParam := flag.String("championship", "", "ID do campeonato cujas estatísticas serão recalculadas")
+	flag.Parse()
+
+	championshipID, err := uuid.Parse(*championshipIDParam)
+	if err != nil {
+		log.Fatalf("ID de campeonato inválido: %q", *championshipIDParam)
+	}
+
+	config.LoadEnv()
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, config.GetRequiredEnv("DATABASE_URL"))
+	if err != nil {
+		log.Fatalf("Falha ao conectar ao banco de dados: %v", err)
+	}
+	defer pool.Close()
+
+	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
+
+	statisticsService := service.NewStatisticsService(
+		repository.NewStatisticsRepositoryPg(pool),
+		championshipRepo,
+		repository.NewTeamRepositoryPg(pool),
+		matchRepo,
+		repository.NewCupStatisticsRepositoryPg(pool),
+		repository.NewStandingsSnapshotRepositoryPg(pool),
+	)
+	playerStatisticsService := service.NewPlayerStatisticsService(
+		repository.NewPlayerStatisticsRepositoryPg(pool),
+		championshipRepo,
+		matchRepo,
+		matchEventRepo,
+		repository.NewLineupRepositoryPg(pool),
+		repository.NewPlayerRepositoryPg(pool),
+	)
+	suspensionService := service.NewSuspensionService(
+		repository.NewSuspensionRepositoryPg(pool),
+		championshipRepo,
+		matchRepo,
+		matchEventRepo,
+	)
+
+	if err := statisticsService.RebuildStatistics(ctx, championshipID); err != nil {
+		log.Fatalf("Falha ao recalcular as estatísticas: %v", err)
+	}
+	if err := playerStatisticsService.RebuildPlayerStatistics(ctx, championshipID); err != nil {
+		log.Fatalf("Falha ao recalcular as estatísticas dos jogadores: %v", err)
+	}
+	if err := suspensionService.RebuildSuspensions(ctx, championshipID); err != nil {
+		log.Fatalf("Falha ao recalcular as suspensões: %v", err)
+	}
+
+	log.Printf("Estatísticas do campeonato %s recalculadas", championshipID)
+}
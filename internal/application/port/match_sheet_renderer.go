@@ -0,0 +1,10 @@
+package port
+
+import (
+	"champi-maker/internal/domain/entity"
+	"io"
+)
+
+type MatchSheetRenderer interface {
+	RenderMatchSheet(w io.Writer, sheet *entity.MatchSheet) error
+}
@@ -17,31 +17,49 @@ type ChampionshipService interface {
 	UpdateChampionship(ctx context.Context, championship *entity.Championship) error
 	DeleteChampionship(ctx context.Context, id uuid.UUID) error
 	ListChampionships(ctx context.Context) ([]*entity.Championship, error)
+	EnrollTeam(ctx context.Context, championshipID, teamID uuid.UUID) error
+	WithdrawTeam(ctx context.Context, championshipID, teamID uuid.UUID) error
+	ListTeams(ctx context.Context, championshipID uuid.UUID) ([]*entity.Team, error)
+	OpenRegistration(ctx context.Context, championshipID uuid.UUID) error
+	StartChampionship(ctx context.Context, championshipID uuid.UUID) error
+	CancelChampionship(ctx context.Context, championshipID uuid.UUID) error
 }
 
 type championshipService struct {
-	championshipRepo repository.ChampionshipRepository
-	teamRepo         repository.TeamRepository
-	messagePublisher port.MessagePublisher
+	championshipRepo     repository.ChampionshipRepository
+	championshipTeamRepo repository.ChampionshipTeamRepository
+	teamRepo             repository.TeamRepository
+	messagePublisher     port.MessagePublisher
 }
 
 func NewChampionshipService(
 	championshipRepo repository.ChampionshipRepository,
+	championshipTeamRepo repository.ChampionshipTeamRepository,
 	teamRepo repository.TeamRepository,
 	messagePublisher port.MessagePublisher,
 ) ChampionshipService {
 	return &championshipService{
-		championshipRepo: championshipRepo,
-		teamRepo:         teamRepo,
-		messagePublisher: messagePublisher,
+		championshipRepo:     championshipRepo,
+		championshipTeamRepo: championshipTeamRepo,
+		teamRepo:             teamRepo,
+		messagePublisher:     messagePublisher,
 	}
 }
 
-func (s *championshipService) CreateChampionship(ctx context.Context, championship *entity.Championship, teamIDs []uuid.UUID) error {
+func (s *championshipService) CreateChampionship(ctx context.Context, championship *entity.Championship, teamIDs []uuid.UUID) (err error) {
 	if err := championship.Validate(); err != nil {
 		return err
 	}
 
+	if err := validateTeamIDs(teamIDs); err != nil {
+		return err
+	}
+
+	// As regras que dependem do número de times são verificadas novamente no início
+	if err := validateChampionshipSettings(championship, teamIDs); err != nil {
+		return err
+	}
+
 	// Verificar se os times existem
 	for _, teamID := range teamIDs {
 		team, err := s.teamRepo.GetByID(ctx, teamID)
@@ -53,24 +71,85 @@ func (s *championshipService) CreateChampionship(ctx context.Context, championsh
 		}
 	}
 
-	// Definir IDs e timestamps
+	// Registrar a semente dos sorteios para que possam ser reproduzidos
+	if championship.ProgressionType == entity.ProgressionRandomDraw && championship.DrawSeed == 0 {
+		championship.DrawSeed = newDrawSeed()
+	}
+
+	// Definir IDs, status e timestamps
 	championship.ID = uuid.New()
+	championship.Status = entity.ChampionshipStatusDraft
 	championship.CreatedAt = time.Now()
 	championship.UpdatedAt = time.Now()
 
-	// Salvar o campeonato no banco de dados
-	if err := s.championshipRepo.Create(ctx, championship); err != nil {
+	// O campeonato e as inscrições são gravados juntos
+	tx, err := s.championshipRepo.BeginTx(ctx)
+	if err != nil {
 		return err
 	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
 
-	// Publicar mensagem usando a interface
-	if err := s.messagePublisher.PublishChampionshipCreated(ctx, championship.ID, teamIDs); err != nil {
+	// Salvar o campeonato no banco de dados
+	if err = s.championshipRepo.CreateWithTx(ctx, tx, championship); err != nil {
 		return err
 	}
 
+	// Registrar os times participantes
+	for _, teamID := range teamIDs {
+		championshipTeam := &entity.ChampionshipTeam{
+			ChampionshipID: championship.ID,
+			TeamID:         teamID,
+			EnrolledAt:     time.Now(),
+		}
+		if err = s.championshipTeamRepo.EnrollWithTx(ctx, tx, championshipTeam); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// validateTeamIDs recusa times repetidos na lista de participantes.
+func validateTeamIDs(teamIDs []uuid.UUID) error {
+	seen := make(map[uuid.UUID]bool, len(teamIDs))
+	for _, teamID := range teamIDs {
+		if seen[teamID] {
+			return fmt.Errorf("team with ID %s appears more than once in team_ids", teamID)
+		}
+		seen[teamID] = true
+	}
+	return nil
+}
+
+// validateChampionshipSettings verifica as configurações do campeonato para a lista de times.
+func validateChampionshipSettings(championship *entity.Championship, teamIDs []uuid.UUID) error {
+	if championship.Type == entity.ChampionshipTypeGroupsKnockout {
+		if err := validateGroupsKnockoutSettings(championship, len(teamIDs)); err != nil {
+			return err
+		}
+	}
+
+	if err := validateDoubleEliminationSettings(championship, len(teamIDs)); err != nil {
+		return err
+	}
+
+	if err := validateThirdPlaceSettings(championship, len(teamIDs)); err != nil {
+		return err
+	}
+
+	if err := validateTwoLeggedSettings(championship); err != nil {
+		return err
+	}
+
+	return validateSeeds(championship, teamIDs)
+}
+
 func (s *championshipService) GetChampionshipByID(ctx context.Context, id uuid.UUID) (*entity.Championship, error) {
 	return s.championshipRepo.GetByID(ctx, id)
 }
@@ -84,6 +163,14 @@ func (s *championshipService) UpdateChampionship(ctx context.Context, championsh
 		return fmt.Errorf("championship with ID %s not found", championship.ID)
 	}
 
+	// Depois do início, tipo e progressão não podem mais mudar
+	if !existingChampionship.IsEditable() {
+		return fmt.Errorf("championship with ID %s can no longer be edited (status %s)", championship.ID, existingChampionship.Status)
+	}
+
+	// O status só muda pelas transições do ciclo de vida
+	championship.Status = existingChampionship.Status
+
 	// Atualizar os timestamps
 	championship.CreatedAt = existingChampionship.CreatedAt
 	championship.UpdatedAt = time.Now()
@@ -92,6 +179,29 @@ func (s *championshipService) UpdateChampionship(ctx context.Context, championsh
 		return err
 	}
 
+	// A semente dos sorteios é mantida; ao passar para random_draw, uma nova é registrada
+	championship.DrawSeed = existingChampionship.DrawSeed
+	if championship.ProgressionType == entity.ProgressionRandomDraw && championship.DrawSeed == 0 {
+		championship.DrawSeed = newDrawSeed()
+	}
+
+	// Sem cabeças de chave na requisição, mantém os já cadastrados
+	if championship.Seeds == nil {
+		championship.Seeds = existingChampionship.Seeds
+	}
+
+	teams, err := s.championshipTeamRepo.ListTeamsByChampionshipID(ctx, championship.ID)
+	if err != nil {
+		return err
+	}
+	teamIDs := make([]uuid.UUID, len(teams))
+	for i, team := range teams {
+		teamIDs[i] = team.ID
+	}
+	if err := validateSeeds(championship, teamIDs); err != nil {
+		return err
+	}
+
 	if err := s.championshipRepo.Update(ctx, championship); err != nil {
 		return err
 	}
@@ -118,3 +228,161 @@ func (s *championshipService) DeleteChampionship(ctx context.Context, id uuid.UU
 func (s *championshipService) ListChampionships(ctx context.Context) ([]*entity.Championship, error) {
 	return s.championshipRepo.List(ctx)
 }
+
+func (s *championshipService) EnrollTeam(ctx context.Context, championshipID, teamID uuid.UUID) error {
+	if err := s.ensureRegistrationOpen(ctx, championshipID); err != nil {
+		return err
+	}
+
+	team, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if team == nil {
+		return fmt.Errorf("team with ID %s not found", teamID)
+	}
+
+	existing, err := s.championshipTeamRepo.GetByChampionshipAndTeam(ctx, championshipID, teamID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("team with ID %s is already enrolled in championship %s", teamID, championshipID)
+	}
+
+	championshipTeam := &entity.ChampionshipTeam{
+		ChampionshipID: championshipID,
+		TeamID:         teamID,
+		EnrolledAt:     time.Now(),
+	}
+	if err := championshipTeam.Validate(); err != nil {
+		return err
+	}
+
+	return s.championshipTeamRepo.Enroll(ctx, championshipTeam)
+}
+
+func (s *championshipService) WithdrawTeam(ctx context.Context, championshipID, teamID uuid.UUID) error {
+	if err := s.ensureRegistrationOpen(ctx, championshipID); err != nil {
+		return err
+	}
+
+	existing, err := s.championshipTeamRepo.GetByChampionshipAndTeam(ctx, championshipID, teamID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("team with ID %s is not enrolled in championship %s", teamID, championshipID)
+	}
+
+	return s.championshipTeamRepo.Withdraw(ctx, championshipID, teamID)
+}
+
+func (s *championshipService) ListTeams(ctx context.Context, championshipID uuid.UUID) ([]*entity.Team, error) {
+	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
+	if err != nil {
+		return nil, err
+	}
+	if championship == nil {
+		return nil, fmt.Errorf("championship with ID %s not found", championshipID)
+	}
+
+	return s.championshipTeamRepo.ListTeamsByChampionshipID(ctx, championshipID)
+}
+
+// ensureRegistrationOpen impede alterar os participantes depois do início do campeonato.
+func (s *championshipService) ensureRegistrationOpen(ctx context.Context, championshipID uuid.UUID) error {
+	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
+	if err != nil {
+		return err
+	}
+	if championship == nil {
+		return fmt.Errorf("championship with ID %s not found", championshipID)
+	}
+
+	if !championship.IsEditable() {
+		return fmt.Errorf("championship with ID %s is not accepting registrations (status %s)", championshipID, championship.Status)
+	}
+
+	return nil
+}
+
+func (s *championshipService) OpenRegistration(ctx context.Context, championshipID uuid.UUID) error {
+	championship, err := s.getForTransition(ctx, championshipID, entity.ChampionshipStatusRegistration)
+	if err != nil {
+		return err
+	}
+
+	championship.Status = entity.ChampionshipStatusRegistration
+	return s.championshipRepo.Update(ctx, championship)
+}
+
+// StartChampionship encerra as inscrições e dispara a geração das partidas com os times inscritos.
+func (s *championshipService) StartChampionship(ctx context.Context, championshipID uuid.UUID) error {
+	championship, err := s.getForTransition(ctx, championshipID, entity.ChampionshipStatusInProgress)
+	if err != nil {
+		return err
+	}
+
+	teams, err := s.championshipTeamRepo.ListTeamsByChampionshipID(ctx, championshipID)
+	if err != nil {
+		return err
+	}
+	if len(teams) < 2 {
+		return fmt.Errorf("championship with ID %s needs at least 2 enrolled teams to start", championshipID)
+	}
+
+	teamIDs := make([]uuid.UUID, len(teams))
+	for i, team := range teams {
+		teamIDs[i] = team.ID
+	}
+
+	if err := validateChampionshipSettings(championship, teamIDs); err != nil {
+		return err
+	}
+
+	previousStatus := championship.Status
+	championship.Status = entity.ChampionshipStatusInProgress
+	if err := s.championshipRepo.Update(ctx, championship); err != nil {
+		return err
+	}
+
+	// Publicar mensagem usando a interface. Sem a mensagem as partidas não são geradas, então o
+	// campeonato volta ao status anterior e pode ser iniciado de novo
+	if err := s.messagePublisher.PublishChampionshipCreated(ctx, championship.ID, teamIDs); err != nil {
+		championship.Status = previousStatus
+		if revertErr := s.championshipRepo.Update(ctx, championship); revertErr != nil {
+			return fmt.Errorf("failed to publish start of championship %s: %v; failed to restore its status: %w", championship.ID, err, revertErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *championshipService) CancelChampionship(ctx context.Context, championshipID uuid.UUID) error {
+	championship, err := s.getForTransition(ctx, championshipID, entity.ChampionshipStatusCancelled)
+	if err != nil {
+		return err
+	}
+
+	championship.Status = entity.ChampionshipStatusCancelled
+	return s.championshipRepo.Update(ctx, championship)
+}
+
+// getForTransition busca o campeonato e verifica se ele pode passar para o status informado.
+func (s *championshipService) getForTransition(ctx context.Context, championshipID uuid.UUID, status entity.ChampionshipStatus) (*entity.Championship, error) {
+	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
+	if err != nil {
+		return nil, err
+	}
+	if championship == nil {
+		return nil, fmt.Errorf("championship with ID %s not found", championshipID)
+	}
+
+	if !championship.CanTransitionTo(status) {
+		return nil, fmt.Errorf("championship with ID %s cannot go from %s to %s", championshipID, championship.Status, status)
+	}
+
+	return championship, nil
+}
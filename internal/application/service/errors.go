@@ -0,0 +1,43 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Categorias de erro dos serviços. Os handlers as usam, com errors.Is, para escolher o status
+// da resposta; a mensagem devolvida continua sendo a do erro original.
+var (
+	ErrNotFound = errors.New("recurso não encontrado")
+	ErrInvalid  = errors.New("requisição inválida")
+	ErrConflict = errors.New("operação incompatível com o estado atual")
+)
+
+// kindError associa um erro a uma das categorias acima sem alterar a sua mensagem.
+type kindError struct {
+	kind error
+	err  error
+}
+
+func (e *kindError) Error() string {
+	return e.err.Error()
+}
+
+func (e *kindError) Unwrap() []error {
+	return []error{e.kind, e.err}
+}
+
+func notFoundf(format string, args ...any) error {
+	return &kindError{kind: ErrNotFound, err: fmt.Errorf(format, args...)}
+}
+
+func conflictf(format string, args ...any) error {
+	return &kindError{kind: ErrConflict, err: fmt.Errorf(format, args...)}
+}
+
+func invalid(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &kindError{kind: ErrInvalid, err: err}
+}
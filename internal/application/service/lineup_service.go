@@ -0,0 +1,268 @@
+package service
+
+import (
+	"champi-maker/internal/application/port"
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type LineupService interface {
+	SubmitLineup(ctx context.Context, lineup *entity.Lineup) error
+	GetLineup(ctx context.Context, matchID, teamID uuid.UUID) (*entity.Lineup, error)
+	ListLineups(ctx context.Context, matchID uuid.UUID) ([]*entity.Lineup, error)
+	DeleteLineup(ctx context.Context, matchID, teamID uuid.UUID) error
+	GetMatchSheet(ctx context.Context, matchID uuid.UUID) (*entity.MatchSheet, error)
+	ExportMatchSheet(ctx context.Context, matchID uuid.UUID, w io.Writer) error
+}
+
+type lineupService struct {
+	lineupRepo             repository.LineupRepository
+	matchRepo              repository.MatchRepository
+	championshipRepo       repository.ChampionshipRepository
+	teamRepo               repository.TeamRepository
+	championshipPlayerRepo repository.ChampionshipPlayerRepository
+	suspensionService      SuspensionService
+	matchSheetRenderer     port.MatchSheetRenderer
+}
+
+func NewLineupService(
+	lineupRepo repository.LineupRepository,
+	matchRepo repository.MatchRepository,
+	championshipRepo repository.ChampionshipRepository,
+	teamRepo repository.TeamRepository,
+	championshipPlayerRepo repository.ChampionshipPlayerRepository,
+	suspensionService SuspensionService,
+	matchSheetRenderer port.MatchSheetRenderer,
+) LineupService {
+	return &lineupService{
+		lineupRepo:             lineupRepo,
+		matchRepo:              matchRepo,
+		championshipRepo:       championshipRepo,
+		teamRepo:               teamRepo,
+		championshipPlayerRepo: championshipPlayerRepo,
+		suspensionService:      suspensionService,
+		matchSheetRenderer:     matchSheetRenderer,
+	}
+}
+
+// SubmitLineup grava a escalação do time para a partida, substituindo a enviada antes. Os
+// jogadores são conferidos com os inscritos do time no campeonato, com as suspensões e com as
+// regras de escalação do campeonato.
+func (s *lineupService) SubmitLineup(ctx context.Context, lineup *entity.Lineup) (err error) {
+	tx, err := s.matchRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	match, err := s.getMatchForLineupsWithTx(ctx, tx, lineup.MatchID)
+	if err != nil {
+		return err
+	}
+	if !hasTeam(match, lineup.TeamID) {
+		return fmt.Errorf("o time com ID %s não disputa a partida com ID %s", lineup.TeamID, match.ID)
+	}
+
+	championship, err := s.championshipRepo.GetByID(ctx, match.ChampionshipID)
+	if err != nil {
+		return err
+	}
+	if championship == nil {
+		return fmt.Errorf("campeonato com ID %s não encontrado", match.ChampionshipID)
+	}
+
+	squad, err := s.championshipPlayerRepo.ListPlayersByChampionshipAndTeam(ctx, championship.ID, lineup.TeamID)
+	if err != nil {
+		return err
+	}
+	if len(squad) == 0 {
+		return fmt.Errorf("o time com ID %s não tem jogadores inscritos no campeonato", lineup.TeamID)
+	}
+	suspended, err := s.suspensionService.SuspendedPlayers(ctx, match)
+	if err != nil {
+		return err
+	}
+
+	// O elenco vale na data da partida; partidas sem data usam o momento do envio
+	lineup.SubmittedAt = time.Now()
+	when := lineup.SubmittedAt
+	if match.MatchDate != nil {
+		when = *match.MatchDate
+	}
+	if err = checkLineup(championship.Squad(), lineup, squad, suspended, when); err != nil {
+		return err
+	}
+	if err = lineup.Validate(); err != nil {
+		return err
+	}
+
+	if err = s.lineupRepo.SaveWithTx(ctx, tx, lineup); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *lineupService) GetLineup(ctx context.Context, matchID, teamID uuid.UUID) (*entity.Lineup, error) {
+	return s.lineupRepo.GetByMatchAndTeam(ctx, matchID, teamID)
+}
+
+func (s *lineupService) ListLineups(ctx context.Context, matchID uuid.UUID) ([]*entity.Lineup, error) {
+	return s.lineupRepo.ListByMatch(ctx, matchID)
+}
+
+// DeleteLineup remove a escalação enviada pelo time, o que só é possível antes do início da
+// partida.
+func (s *lineupService) DeleteLineup(ctx context.Context, matchID, teamID uuid.UUID) (err error) {
+	tx, err := s.matchRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	if _, err = s.getMatchForLineupsWithTx(ctx, tx, matchID); err != nil {
+		return err
+	}
+	if err = s.lineupRepo.DeleteWithTx(ctx, tx, matchID, teamID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetMatchSheet reúne os dados da súmula da partida: campeonato, times e escalações.
+func (s *lineupService) GetMatchSheet(ctx context.Context, matchID uuid.UUID) (*entity.MatchSheet, error) {
+	match, err := s.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match == nil {
+		return nil, fmt.Errorf("partida com ID %s não encontrada", matchID)
+	}
+	if match.HomeTeamID == nil || match.AwayTeamID == nil {
+		return nil, fmt.Errorf("a partida com ID %s ainda não tem os dois times definidos", matchID)
+	}
+
+	championship, err := s.championshipRepo.GetByID(ctx, match.ChampionshipID)
+	if err != nil {
+		return nil, err
+	}
+	if championship == nil {
+		return nil, fmt.Errorf("campeonato com ID %s não encontrado", match.ChampionshipID)
+	}
+
+	sheet := &entity.MatchSheet{Championship: championship, Match: match}
+	if sheet.HomeTeam, err = s.teamRepo.GetByID(ctx, *match.HomeTeamID); err != nil {
+		return nil, err
+	}
+	if sheet.AwayTeam, err = s.teamRepo.GetByID(ctx, *match.AwayTeamID); err != nil {
+		return nil, err
+	}
+	if sheet.HomeTeam == nil || sheet.AwayTeam == nil {
+		return nil, fmt.Errorf("times da partida com ID %s não encontrados", matchID)
+	}
+
+	lineups, err := s.lineupRepo.ListByMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	for _, lineup := range lineups {
+		if lineup.TeamID == *match.HomeTeamID {
+			sheet.HomeLineup = lineup
+		} else if lineup.TeamID == *match.AwayTeamID {
+			sheet.AwayLineup = lineup
+		}
+	}
+
+	return sheet, nil
+}
+
+// ExportMatchSheet gera a súmula da partida para impressão.
+func (s *lineupService) ExportMatchSheet(ctx context.Context, matchID uuid.UUID, w io.Writer) error {
+	sheet, err := s.GetMatchSheet(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	return s.matchSheetRenderer.RenderMatchSheet(w, sheet)
+}
+
+// getMatchForLineupsWithTx busca a partida e verifica se ela ainda aceita escalações: apenas
+// partidas agendadas ou adiadas. A escalação fica travada a partir do início da partida.
+func (s *lineupService) getMatchForLineupsWithTx(ctx context.Context, tx pgx.Tx, matchID uuid.UUID) (*entity.Match, error) {
+	match, err := s.matchRepo.GetByIDWithTx(ctx, tx, matchID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("partida com ID %s não encontrada", matchID)
+		}
+		return nil, err
+	}
+
+	switch match.Status {
+	case entity.MatchStatusScheduled, entity.MatchStatusPostponed:
+	default:
+		return nil, fmt.Errorf("a escalação da partida com ID %s não pode mais ser alterada (status %s)", matchID, match.Status)
+	}
+
+	return match, nil
+}
+
+// checkLineup confere os jogadores relacionados com o elenco inscrito na data da partida, com
+// as suspensões e com o número de titulares e reservas permitido, e preenche nome, número e
+// posição a partir do elenco.
+func checkLineup(rules entity.SquadRules, lineup *entity.Lineup, squad []*entity.Player, suspended map[uuid.UUID]*entity.Suspension, when time.Time) error {
+	registered := make(map[uuid.UUID]*entity.Player, len(squad))
+	for _, player := range squad {
+		registered[player.ID] = player
+	}
+
+	listed := make(map[uuid.UUID]bool, len(lineup.Players))
+	starters := 0
+	for _, lineupPlayer := range lineup.Players {
+		player, ok := registered[lineupPlayer.PlayerID]
+		if !ok {
+			return fmt.Errorf("o jogador com ID %s não está inscrito pelo time no campeonato", lineupPlayer.PlayerID)
+		}
+		if listed[player.ID] {
+			return fmt.Errorf("o jogador %s foi relacionado mais de uma vez", player.Name)
+		}
+		listed[player.ID] = true
+		if !player.IsRegisteredAt(when) {
+			return fmt.Errorf("o jogador %s não estava no elenco do time na data da partida", player.Name)
+		}
+		if _, ok := suspended[player.ID]; ok {
+			return fmt.Errorf("o jogador %s está suspenso nesta partida", player.Name)
+		}
+
+		lineupPlayer.Name = player.Name
+		lineupPlayer.ShirtNumber = player.ShirtNumber
+		lineupPlayer.Position = player.Position
+		if lineupPlayer.Starter {
+			starters++
+		}
+	}
+
+	if starters < rules.MinStarters || starters > rules.Starters {
+		return fmt.Errorf("a escalação deve ter de %d a %d titulares", rules.MinStarters, rules.Starters)
+	}
+	if substitutes := len(lineup.Players) - starters; substitutes > rules.Substitutes {
+		return fmt.Errorf("a escalação pode ter no máximo %d reservas", rules.Substitutes)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckLineup(t *testing.T) {
+	now := time.Now()
+	left := now.Add(-24 * time.Hour)
+	newPlayer := func(name string, number int) *entity.Player {
+		return &entity.Player{
+			ID:          uuid.New(),
+			Name:        name,
+			ShirtNumber: number,
+			Position:    entity.PlayerPositionMidfielder,
+			JoinedAt:    now.Add(-30 * 24 * time.Hour),
+		}
+	}
+	first, second, third, fourth := newPlayer("Primeiro", 1), newPlayer("Segundo", 2), newPlayer("Terceiro", 3), newPlayer("Quarto", 4)
+	squad := []*entity.Player{first, second, third, fourth}
+	rules := entity.SquadRules{Starters: 3, MinStarters: 2, Substitutes: 1}
+
+	lineupOf := func(starters []uuid.UUID, substitutes ...uuid.UUID) *entity.Lineup {
+		lineup := &entity.Lineup{}
+		for _, playerID := range starters {
+			lineup.Players = append(lineup.Players, &entity.LineupPlayer{PlayerID: playerID, Starter: true})
+		}
+		for _, playerID := range substitutes {
+			lineup.Players = append(lineup.Players, &entity.LineupPlayer{PlayerID: playerID})
+		}
+		return lineup
+	}
+
+	lineup := lineupOf([]uuid.UUID{first.ID, second.ID}, third.ID)
+	require.NoError(t, checkLineup(rules, lineup, squad, nil, now))
+	assert.Equal(t, "Terceiro", lineup.Players[2].Name)
+	assert.Equal(t, 3, lineup.Players[2].ShirtNumber)
+
+	// Titulares abaixo do mínimo ou acima do máximo
+	err := checkLineup(rules, lineupOf([]uuid.UUID{first.ID}), squad, nil, now)
+	assert.EqualError(t, err, "a escalação deve ter de 2 a 3 titulares")
+	err = checkLineup(rules, lineupOf([]uuid.UUID{first.ID, second.ID, third.ID, fourth.ID}), squad, nil, now)
+	assert.Error(t, err)
+
+	// Reservas acima do permitido
+	err = checkLineup(rules, lineupOf([]uuid.UUID{first.ID, second.ID}, third.ID, fourth.ID), squad, nil, now)
+	assert.EqualError(t, err, "a escalação pode ter no máximo 1 reservas")
+
+	// Jogador repetido, de fora da lista de inscritos ou que já deixou o time
+	err = checkLineup(rules, lineupOf([]uuid.UUID{first.ID, second.ID}, first.ID), squad, nil, now)
+	assert.EqualError(t, err, "o jogador Primeiro foi relacionado mais de uma vez")
+	err = checkLineup(rules, lineupOf([]uuid.UUID{first.ID, uuid.New()}), squad, nil, now)
+	assert.Error(t, err)
+	fourth.LeftAt = &left
+	err = checkLineup(rules, lineupOf([]uuid.UUID{first.ID, fourth.ID}), squad, nil, now)
+	assert.EqualError(t, err, "o jogador Quarto não estava no elenco do time na data da partida")
+
+	// Jogador suspenso
+	suspended := map[uuid.UUID]*entity.Suspension{second.ID: {PlayerID: second.ID}}
+	err = checkLineup(rules, lineupOf([]uuid.UUID{first.ID, third.ID}, second.ID), squad, suspended, now)
+	assert.EqualError(t, err, "o jogador Segundo está suspenso nesta partida")
+}
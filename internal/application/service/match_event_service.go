@@ -0,0 +1,346 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type MatchEventService interface {
+	AddEvent(ctx context.Context, event *entity.MatchEvent) error
+	DeleteEvent(ctx context.Context, matchID, eventID uuid.UUID) error
+	ListEventsByMatch(ctx context.Context, matchID uuid.UUID) ([]*entity.MatchEvent, error)
+}
+
+type matchEventService struct {
+	matchEventRepo          repository.MatchEventRepository
+	matchRepo               repository.MatchRepository
+	championshipPlayerRepo  repository.ChampionshipPlayerRepository
+	lineupRepo              repository.LineupRepository
+	playerStatisticsService PlayerStatisticsService
+	suspensionService       SuspensionService
+}
+
+func NewMatchEventService(
+	matchEventRepo repository.MatchEventRepository,
+	matchRepo repository.MatchRepository,
+	championshipPlayerRepo repository.ChampionshipPlayerRepository,
+	lineupRepo repository.LineupRepository,
+	playerStatisticsService PlayerStatisticsService,
+	suspensionService SuspensionService,
+) MatchEventService {
+	return &matchEventService{
+		matchEventRepo:          matchEventRepo,
+		matchRepo:               matchRepo,
+		championshipPlayerRepo:  championshipPlayerRepo,
+		lineupRepo:              lineupRepo,
+		playerStatisticsService: playerStatisticsService,
+		suspensionService:       suspensionService,
+	}
+}
+
+// AddEvent registra um lance da partida. Em partidas em andamento os gols atualizam o placar;
+// em partidas encerradas ou abandonadas os gols registrados não podem passar do placar lançado.
+// Os números e as suspensões dos jogadores são recalculados quando a partida já foi encerrada.
+func (s *matchEventService) AddEvent(ctx context.Context, event *entity.MatchEvent) (err error) {
+	tx, err := s.matchRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	match, err := s.getMatchForEventsWithTx(ctx, tx, event.MatchID)
+	if err != nil {
+		return err
+	}
+	if !hasTeam(match, event.TeamID) {
+		return fmt.Errorf("o time com ID %s não disputa a partida com ID %s", event.TeamID, match.ID)
+	}
+	if event.ExtraTime && match.Status != entity.MatchStatusInProgress && !match.HasExtraTime {
+		return errors.New("a partida não teve prorrogação")
+	}
+	if err = s.resolvePlayers(ctx, match, event); err != nil {
+		return err
+	}
+	if err = event.Validate(); err != nil {
+		return err
+	}
+
+	if err = s.matchEventRepo.CreateWithTx(ctx, tx, event); err != nil {
+		return err
+	}
+	if event.IsGoal() {
+		if err = s.syncScoreWithTx(ctx, tx, match); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return s.rebuildPlayerRecords(ctx, match)
+}
+
+// DeleteEvent remove um lance registrado por engano. Em partidas em andamento o placar é
+// recalculado sem o gol removido; em partidas encerradas, os números e as suspensões dos
+// jogadores.
+func (s *matchEventService) DeleteEvent(ctx context.Context, matchID, eventID uuid.UUID) (err error) {
+	event, err := s.matchEventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if event == nil || event.MatchID != matchID {
+		return fmt.Errorf("evento com ID %s não encontrado na partida com ID %s", eventID, matchID)
+	}
+
+	tx, err := s.matchRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	match, err := s.getMatchForEventsWithTx(ctx, tx, matchID)
+	if err != nil {
+		return err
+	}
+
+	if err = s.matchEventRepo.DeleteWithTx(ctx, tx, eventID); err != nil {
+		return err
+	}
+	if event.IsGoal() {
+		if err = s.syncScoreWithTx(ctx, tx, match); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return s.rebuildPlayerRecords(ctx, match)
+}
+
+// rebuildPlayerRecords recalcula os números e as suspensões dos jogadores do campeonato depois
+// de uma alteração nos lances de uma partida encerrada.
+func (s *matchEventService) rebuildPlayerRecords(ctx context.Context, match *entity.Match) error {
+	if match.Status != entity.MatchStatusFinished {
+		return nil
+	}
+	if err := s.playerStatisticsService.RebuildPlayerStatistics(ctx, match.ChampionshipID); err != nil {
+		return err
+	}
+	return s.suspensionService.RebuildSuspensions(ctx, match.ChampionshipID)
+}
+
+// resolvePlayers confere os jogadores do lance com a lista de inscritos do time no campeonato e
+// preenche os nomes a partir do elenco. Jogadores suspensos na partida, ou fora da escalação
+// quando o time enviou a sua, não podem aparecer nos lances. Times sem inscritos aceitam lances
+// só com os nomes.
+func (s *matchEventService) resolvePlayers(ctx context.Context, match *entity.Match, event *entity.MatchEvent) error {
+	if event.AssistPlayerID != nil && event.Type != entity.MatchEventGoal {
+		return errors.New("apenas gols têm assistência")
+	}
+	if event.SubstitutePlayerID != nil && event.Type != entity.MatchEventSubstitution {
+		return errors.New("apenas substituições têm jogador substituto")
+	}
+
+	squad, err := s.championshipPlayerRepo.ListPlayersByChampionshipAndTeam(ctx, match.ChampionshipID, event.TeamID)
+	if err != nil {
+		return err
+	}
+	if len(squad) == 0 {
+		if event.PlayerID != nil || event.AssistPlayerID != nil || event.SubstitutePlayerID != nil {
+			return fmt.Errorf("o time com ID %s não tem jogadores inscritos no campeonato", event.TeamID)
+		}
+		return nil
+	}
+
+	// O elenco vale na data da partida; partidas sem data usam o momento do registro
+	when := time.Now()
+	if match.MatchDate != nil {
+		when = *match.MatchDate
+	}
+	registered := make(map[uuid.UUID]*entity.Player, len(squad))
+	for _, player := range squad {
+		registered[player.ID] = player
+	}
+	suspended, err := s.suspensionService.SuspendedPlayers(ctx, match)
+	if err != nil {
+		return err
+	}
+	lineup, err := s.lineupRepo.GetByMatchAndTeam(ctx, match.ID, event.TeamID)
+	if err != nil {
+		return err
+	}
+	resolve := func(playerID *uuid.UUID, required bool) (*entity.Player, error) {
+		if playerID == nil {
+			if required {
+				return nil, errors.New("informe o jogador inscrito no campeonato")
+			}
+			return nil, nil
+		}
+		player, ok := registered[*playerID]
+		if !ok {
+			return nil, fmt.Errorf("o jogador com ID %s não está inscrito pelo time no campeonato", *playerID)
+		}
+		if !player.IsRegisteredAt(when) {
+			return nil, fmt.Errorf("o jogador %s não estava no elenco do time na data da partida", player.Name)
+		}
+		if _, ok := suspended[player.ID]; ok {
+			return nil, fmt.Errorf("o jogador %s está suspenso nesta partida", player.Name)
+		}
+		if lineup != nil && !lineup.Includes(player.ID) {
+			return nil, fmt.Errorf("o jogador %s não está na escalação do time para a partida", player.Name)
+		}
+		return player, nil
+	}
+
+	player, err := resolve(event.PlayerID, true)
+	if err != nil {
+		return err
+	}
+	event.PlayerName = player.Name
+
+	assist, err := resolve(event.AssistPlayerID, false)
+	if err != nil {
+		return err
+	}
+	if assist != nil && assist.ID == player.ID {
+		return errors.New("o autor do gol não pode ser o autor da assistência")
+	}
+
+	substitute, err := resolve(event.SubstitutePlayerID, event.Type == entity.MatchEventSubstitution)
+	if err != nil {
+		return err
+	}
+	if substitute != nil {
+		if substitute.ID == player.ID {
+			return errors.New("o jogador que entra deve ser diferente do que sai")
+		}
+		event.SubstitutePlayerName = substitute.Name
+	}
+
+	return nil
+}
+
+func (s *matchEventService) ListEventsByMatch(ctx context.Context, matchID uuid.UUID) ([]*entity.MatchEvent, error) {
+	return s.matchEventRepo.ListByMatchID(ctx, matchID)
+}
+
+// getMatchForEventsWithTx busca a partida e verifica se ela aceita eventos: apenas partidas
+// em andamento, abandonadas ou encerradas em campo.
+func (s *matchEventService) getMatchForEventsWithTx(ctx context.Context, tx pgx.Tx, matchID uuid.UUID) (*entity.Match, error) {
+	match, err := s.matchRepo.GetByIDWithTx(ctx, tx, matchID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("partida com ID %s não encontrada", matchID)
+		}
+		return nil, err
+	}
+
+	switch match.Status {
+	case entity.MatchStatusInProgress, entity.MatchStatusAbandoned, entity.MatchStatusFinished:
+	default:
+		return nil, fmt.Errorf("a partida com ID %s não aceita eventos (status %s)", matchID, match.Status)
+	}
+	if match.Walkover {
+		return nil, fmt.Errorf("a partida com ID %s foi decidida por W.O. e não aceita eventos", matchID)
+	}
+
+	return match, nil
+}
+
+// syncScoreWithTx confere o placar da partida com os gols registrados. Em partidas em andamento
+// o placar passa a ser o dos gols; nas demais, os gols não podem passar do placar lançado.
+func (s *matchEventService) syncScoreWithTx(ctx context.Context, tx pgx.Tx, match *entity.Match) error {
+	events, err := s.matchEventRepo.ListByMatchIDWithTx(ctx, tx, match.ID)
+	if err != nil {
+		return err
+	}
+	score := scoreFromEvents(match, events)
+
+	if match.Status != entity.MatchStatusInProgress {
+		return score.fitsIn(match)
+	}
+
+	score.applyTo(match)
+	match.UpdatedAt = time.Now()
+	return s.matchRepo.UpdateWithTx(ctx, tx, match)
+}
+
+// eventScore é o placar da partida calculado pelos gols registrados.
+type eventScore struct {
+	goals                        int
+	home, away                   int
+	homeExtraTime, awayExtraTime int
+}
+
+// scoreFromEvents soma os gols dos eventos, separando tempo normal e prorrogação.
+func scoreFromEvents(match *entity.Match, events []*entity.MatchEvent) eventScore {
+	var score eventScore
+	for _, event := range events {
+		if !event.IsGoal() {
+			continue
+		}
+		score.goals++
+
+		home := event.ScoresForHome(match)
+		switch {
+		case home && event.ExtraTime:
+			score.homeExtraTime++
+		case home:
+			score.home++
+		case event.ExtraTime:
+			score.awayExtraTime++
+		default:
+			score.away++
+		}
+	}
+	return score
+}
+
+// applyTo grava o placar dos gols na partida.
+func (sc eventScore) applyTo(match *entity.Match) {
+	match.ScoreHome, match.ScoreAway = sc.home, sc.away
+	match.ScoreHomeExtraTime, match.ScoreAwayExtraTime = sc.homeExtraTime, sc.awayExtraTime
+	match.HasExtraTime = match.HasExtraTime || sc.homeExtraTime > 0 || sc.awayExtraTime > 0
+}
+
+// fitsIn verifica se os gols registrados cabem no placar lançado, o que permite registrar os
+// lances de uma partida já encerrada aos poucos.
+func (sc eventScore) fitsIn(match *entity.Match) error {
+	if sc.home > match.ScoreHome || sc.away > match.ScoreAway ||
+		sc.homeExtraTime > match.ScoreHomeExtraTime || sc.awayExtraTime > match.ScoreAwayExtraTime {
+		return fmt.Errorf("os gols registrados passam do placar da partida (%d x %d)", match.ScoreHome, match.ScoreAway)
+	}
+	return nil
+}
+
+// matches verifica se o placar lançado é o mesmo dos gols registrados. Sem gols registrados
+// qualquer placar é aceito.
+func (sc eventScore) matches(match *entity.Match) error {
+	if sc.goals == 0 {
+		return nil
+	}
+	if sc.home != match.ScoreHome || sc.away != match.ScoreAway ||
+		sc.homeExtraTime != match.ScoreHomeExtraTime || sc.awayExtraTime != match.ScoreAwayExtraTime {
+		return fmt.Errorf("o placar não confere com os gols registrados (%d x %d no tempo normal, %d x %d na prorrogação)",
+			sc.home, sc.away, sc.homeExtraTime, sc.awayExtraTime)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreFromEvents(t *testing.T) {
+	home, away := uuid.New(), uuid.New()
+	match := &entity.Match{HomeTeamID: &home, AwayTeamID: &away, Status: entity.MatchStatusInProgress}
+
+	events := []*entity.MatchEvent{
+		{TeamID: home, Type: entity.MatchEventGoal, Minute: 10},
+		{TeamID: home, Type: entity.MatchEventYellowCard, Minute: 15},
+		{TeamID: home, Type: entity.MatchEventOwnGoal, Minute: 40},
+		{TeamID: away, Type: entity.MatchEventGoal, Minute: 75},
+		{TeamID: home, Type: entity.MatchEventGoal, Minute: 105, ExtraTime: true},
+	}
+
+	score := scoreFromEvents(match, events)
+	assert.Equal(t, eventScore{goals: 4, home: 1, away: 2, homeExtraTime: 1}, score)
+
+	score.applyTo(match)
+	assert.Equal(t, 1, match.ScoreHome)
+	assert.Equal(t, 2, match.ScoreAway)
+	assert.Equal(t, 1, match.ScoreHomeExtraTime)
+	assert.True(t, match.HasExtraTime)
+}
+
+func TestEventScore_FitsInAndMatches(t *testing.T) {
+	home, away := uuid.New(), uuid.New()
+	match := finishedMatch(home, away, 2, 1)
+
+	// Sem gols registrados qualquer placar é aceito
+	assert.NoError(t, eventScore{}.matches(match))
+	assert.NoError(t, eventScore{}.fitsIn(match))
+
+	// Os lances de uma partida encerrada podem ser registrados aos poucos
+	partial := eventScore{goals: 1, home: 1}
+	assert.NoError(t, partial.fitsIn(match))
+	assert.Error(t, partial.matches(match))
+
+	complete := eventScore{goals: 3, home: 2, away: 1}
+	assert.NoError(t, complete.fitsIn(match))
+	assert.NoError(t, complete.matches(match))
+
+	exceeding := eventScore{goals: 3, home: 1, away: 2}
+	assert.Error(t, exceeding.fitsIn(match))
+	assert.Error(t, exceeding.matches(match))
+}
@@ -6,7 +6,6 @@ import (
 	"champi-maker/internal/domain/repository"
 	"errors"
 	"math"
-	"math/rand/v2"
 	"time"
 
 	"context"
@@ -16,6 +15,9 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
+// groupStagePhase é a fase das partidas de grupos; o mata-mata começa na fase seguinte.
+const groupStagePhase = 1
+
 type MatchResultUpdate struct {
 	ScoreHome          int
 	ScoreAway          int
@@ -25,33 +27,53 @@ type MatchResultUpdate struct {
 	HasPenalties       bool
 	ScoreHomePenalties int
 	ScoreAwayPenalties int
+	Walkover           bool // Resultado atribuído fora de campo (W.O.)
 }
 
 type MatchService interface {
 	GenerateMatches(ctx context.Context, message application.ChampionshipCreatedMessage) error
 	UpdateMatchResult(ctx context.Context, matchID uuid.UUID, result MatchResultUpdate) error
+	CorrectMatchResult(ctx context.Context, matchID uuid.UUID, result MatchResultUpdate, cascade bool) error
+	StartMatch(ctx context.Context, matchID uuid.UUID) error
+	UpdateLiveScore(ctx context.Context, matchID uuid.UUID, scoreHome, scoreAway int) error
+	FinishMatch(ctx context.Context, matchID uuid.UUID, result MatchResultUpdate) error
+	PostponeMatch(ctx context.Context, matchID uuid.UUID, matchDate *time.Time) error
+	AbandonMatch(ctx context.Context, matchID uuid.UUID, award *MatchAward) error
+	AwardForfeit(ctx context.Context, matchID uuid.UUID, award MatchAward) error
+	DisqualifyTeam(ctx context.Context, championshipID, teamID uuid.UUID) error
 	GetMatchByID(ctx context.Context, matchID uuid.UUID) (*entity.Match, error)
 	ListMatchesByChampionship(ctx context.Context, championshipID uuid.UUID) ([]*entity.Match, error)
+	ListMatchesByRound(ctx context.Context, championshipID uuid.UUID, round int) ([]*entity.Match, error)
+	GenerateNextSwissRound(ctx context.Context, championshipID uuid.UUID) error
 }
 
 type matchService struct {
-	matchRepo         repository.MatchRepository
-	championshipRepo  repository.ChampionshipRepository
-	teamRepo          repository.TeamRepository
-	statisticsService StatisticsService
+	matchRepo               repository.MatchRepository
+	championshipRepo        repository.ChampionshipRepository
+	teamRepo                repository.TeamRepository
+	matchEventRepo          repository.MatchEventRepository
+	statisticsService       StatisticsService
+	playerStatisticsService PlayerStatisticsService
+	suspensionService       SuspensionService
 }
 
 func NewMatchService(
 	matchRepo repository.MatchRepository,
 	championshipRepo repository.ChampionshipRepository,
 	teamRepo repository.TeamRepository,
+	matchEventRepo repository.MatchEventRepository,
 	statisticsService StatisticsService,
+	playerStatisticsService PlayerStatisticsService,
+	suspensionService SuspensionService,
 ) MatchService {
 	return &matchService{
-		matchRepo:         matchRepo,
-		championshipRepo:  championshipRepo,
-		teamRepo:          teamRepo,
-		statisticsService: statisticsService,
+		matchRepo:               matchRepo,
+		championshipRepo:        championshipRepo,
+		teamRepo:                teamRepo,
+		matchEventRepo:          matchEventRepo,
+		statisticsService:       statisticsService,
+		playerStatisticsService: playerStatisticsService,
+		suspensionService:       suspensionService,
 	}
 }
 
@@ -82,17 +104,30 @@ func (s *matchService) GenerateMatches(ctx context.Context, message application.
 	if championship == nil {
 		return fmt.Errorf("championship with ID %s not found", message.ChampionshipID)
 	}
+	if championship.Status != entity.ChampionshipStatusInProgress {
+		err = fmt.Errorf("championship with ID %s is not in progress (status %s)", championship.ID, championship.Status)
+		return err
+	}
 
 	// Gerar as partidas com base no tipo de campeonato
 	var matches []*entity.Match
+	var groups [][]uuid.UUID
 
 	switch championship.Type {
 	case entity.ChampionshipTypeLeague:
 		matches, err = s.generateLeagueMatches(ctx, championship, message.TeamIDs)
 	case entity.ChampionshipTypeCup:
 		matches, err = s.generateCupMatches(ctx, championship, message.TeamIDs)
+	case entity.ChampionshipTypeGroupsKnockout:
+		if err = validateGroupsKnockoutSettings(championship, len(message.TeamIDs)); err != nil {
+			return err
+		}
+		groups = splitIntoGroups(message.TeamIDs, championship.NumGroups)
+		matches, err = s.generateGroupsKnockoutMatches(ctx, championship, groups)
+	case entity.ChampionshipTypeSwiss:
+		matches, err = s.generateSwissFirstRound(ctx, championship, message.TeamIDs)
 	default:
-		return fmt.Errorf("unknown championship type: %s", championship.Type)
+		err = fmt.Errorf("unknown championship type: %s", championship.Type)
 	}
 
 	if err != nil {
@@ -101,7 +136,43 @@ func (s *matchService) GenerateMatches(ctx context.Context, message application.
 
 	// Salvar as partidas no banco de dados
 	for _, match := range matches {
-		if err := s.matchRepo.CreateWithTx(ctx, tx, match); err != nil {
+		if err = s.matchRepo.CreateWithTx(ctx, tx, match); err != nil {
+			return err
+		}
+	}
+
+	// A classificação de cada grupo depende das estatísticas por grupo
+	if groups != nil {
+		if err = s.statisticsService.GenerateGroupStatisticsWithTx(ctx, tx, championship.ID, groups); err != nil {
+			return err
+		}
+	}
+
+	// A classificação da liga nasce com as partidas, para que todo resultado encontre as
+	// estatísticas dos dois times
+	if championship.Type == entity.ChampionshipTypeLeague {
+		if err = s.statisticsService.GenerateInitialStatisticsWithTx(ctx, tx, championship.ID, message.TeamIDs); err != nil {
+			return err
+		}
+	}
+
+	// Byes da primeira fase do mata-mata avançam o time presente imediatamente, e os números do
+	// mata-mata já partem da chave montada
+	if championship.Type == entity.ChampionshipTypeCup {
+		if err = s.resolveByesWithTx(ctx, tx, matches); err != nil {
+			return err
+		}
+		if err = s.statisticsService.RebuildCupStatisticsWithTx(ctx, tx, championship); err != nil {
+			return err
+		}
+	}
+
+	// No sistema suíço a classificação define os emparelhamentos das próximas rodadas
+	if championship.Type == entity.ChampionshipTypeSwiss {
+		if err = s.statisticsService.GenerateInitialStatisticsWithTx(ctx, tx, championship.ID, message.TeamIDs); err != nil {
+			return err
+		}
+		if err = s.applySwissByesWithTx(ctx, tx, matches); err != nil {
 			return err
 		}
 	}
@@ -110,47 +181,95 @@ func (s *matchService) GenerateMatches(ctx context.Context, message application.
 }
 
 func (s *matchService) generateLeagueMatches(ctx context.Context, championship *entity.Championship, teamIDs []uuid.UUID) ([]*entity.Match, error) {
+	return generateRoundRobinMatches(championship, teamIDs, nil), nil
+}
+
+// generateRoundRobinMatches monta a tabela de rodadas pelo método do círculo (tabela de
+// Berger): cada time joga no máximo uma vez por rodada e o mando de campo alterna sempre
+// que possível. Cada turno adicional repete as rodadas com o mando invertido. Em partidas
+// de fase de grupos, groupNumber identifica o grupo; em ligas é nil.
+func generateRoundRobinMatches(championship *entity.Championship, teamIDs []uuid.UUID, groupNumber *int) []*entity.Match {
 	var matches []*entity.Match
 
-	for i := 0; i < len(teamIDs); i++ {
-		for j := i + 1; j < len(teamIDs); j++ {
-			match := &entity.Match{
-				ID:             uuid.New(),
-				ChampionshipID: championship.ID,
-				HomeTeamID:     &teamIDs[i],
-				AwayTeamID:     &teamIDs[j],
-				MatchDate:      nil, // Pode ser definido posteriormente
-				Status:         entity.MatchStatusScheduled,
-				Phase:          1,
-				CreatedAt:      time.Now(),
-				UpdatedAt:      time.Now(),
+	// Com número ímpar de times, quem enfrenta o "bye" (nil) folga na rodada
+	slots := make([]*uuid.UUID, 0, len(teamIDs)+1)
+	for i := range teamIDs {
+		slots = append(slots, &teamIDs[i])
+	}
+	if len(slots)%2 == 1 {
+		slots = append(slots, nil)
+	}
+
+	numSlots := len(slots)
+	roundsPerLeg := numSlots - 1
+
+	for leg := 0; leg < championship.NumLegs(); leg++ {
+		rotation := append([]*uuid.UUID(nil), slots...)
+		for round := 0; round < roundsPerLeg; round++ {
+			for i := 0; i < numSlots/2; i++ {
+				homeTeamID, awayTeamID := rotation[i], rotation[numSlots-1-i]
+				if homeTeamID == nil || awayTeamID == nil {
+					continue // Folga
+				}
+
+				// O time fixo alterna o mando a cada rodada; os demais pares se alternam pela posição
+				swap := i%2 == 1
+				if i == 0 {
+					swap = round%2 == 1
+				}
+				if leg%2 == 1 {
+					swap = !swap // Returno
+				}
+				if swap {
+					homeTeamID, awayTeamID = awayTeamID, homeTeamID
+				}
+
+				match := &entity.Match{
+					ID:             uuid.New(),
+					ChampionshipID: championship.ID,
+					HomeTeamID:     homeTeamID,
+					AwayTeamID:     awayTeamID,
+					MatchDate:      nil, // Pode ser definido posteriormente
+					Status:         entity.MatchStatusScheduled,
+					Phase:          1,
+					Round:          leg*roundsPerLeg + round + 1,
+					GroupNumber:    groupNumber,
+					CreatedAt:      time.Now(),
+					UpdatedAt:      time.Now(),
+				}
+				matches = append(matches, match)
 			}
-			matches = append(matches, match)
+
+			// Girar todos menos o primeiro
+			last := rotation[numSlots-1]
+			copy(rotation[2:], rotation[1:numSlots-1])
+			rotation[1] = last
 		}
 	}
 
-	return matches, nil
+	return matches
 }
 
 func (s *matchService) generateCupMatches(ctx context.Context, championship *entity.Championship, teamIDs []uuid.UUID) ([]*entity.Match, error) {
+	if championship.DoubleElimination {
+		if err := validateDoubleEliminationSettings(championship, len(teamIDs)); err != nil {
+			return nil, err
+		}
+		return generateDoubleEliminationMatches(championship, teamIDs), nil
+	}
+	if err := validateThirdPlaceSettings(championship, len(teamIDs)); err != nil {
+		return nil, err
+	}
+	if err := validateTwoLeggedSettings(championship); err != nil {
+		return nil, err
+	}
+
 	numTeams := len(teamIDs)
 	numPhases := int(math.Ceil(math.Log2(float64(numTeams))))
 	numSlots := int(math.Pow(2, float64(numPhases)))
 
-	// Criar slots, preenchendo com nil para byes
-	slots := make([]*uuid.UUID, numSlots)
-	for i := 0; i < numSlots; i++ {
-		if i < numTeams {
-			slots[i] = &teamIDs[i]
-		} else {
-			slots[i] = nil // Bye
-		}
-	}
-
-	// Embaralhar os slots se necessário
-	if championship.ProgressionType == entity.ProgressionRandomDraw {
-		rand.Shuffle(len(slots), func(i, j int) { slots[i], slots[j] = slots[j], slots[i] })
-	}
+	// Distribuir os times pela chave; as posições nil são byes dos melhores cabeças de chave
+	slots := seededSlots(championship, teamIDs, numSlots)
 
 	// Inicializar as fases
 	phases := make([][]*entity.Match, numPhases)
@@ -176,45 +295,160 @@ func (s *matchService) generateCupMatches(ctx context.Context, championship *ent
 
 	if championship.ProgressionType == entity.ProgressionFixed {
 		// Gerar as partidas das fases subsequentes
-		for phase := 1; phase < numPhases; phase++ {
-			previousPhaseMatches := phases[phase-1]
-			numMatches := len(previousPhaseMatches)
-			currentPhaseMatches := make([]*entity.Match, 0)
-			for i := 0; i < numMatches; i += 2 {
-				leftChildMatch := previousPhaseMatches[i]
-				var rightChildMatch *entity.Match
-				if i+1 < numMatches {
-					rightChildMatch = previousPhaseMatches[i+1]
-				} else {
-					rightChildMatch = nil // Bye
-				}
-				match := &entity.Match{
-					ID:                uuid.New(),
-					ChampionshipID:    championship.ID,
-					LeftChildMatchID:  &leftChildMatch.ID,
-					RightChildMatchID: nil,
-					MatchDate:         nil,
-					Status:            entity.MatchStatusScheduled,
-					Phase:             phase + 1,
-					CreatedAt:         time.Now(),
-					UpdatedAt:         time.Now(),
-				}
-				if rightChildMatch != nil {
-					match.RightChildMatchID = &rightChildMatch.ID
-				}
-				// Atualizar o ParentMatchID nas partidas filhas
-				leftChildMatch.ParentMatchID = &match.ID
-				if rightChildMatch != nil {
-					rightChildMatch.ParentMatchID = &match.ID
-				}
-				currentPhaseMatches = append(currentPhaseMatches, match)
+		buildBracketPhases(championship, phases)
+	}
+
+	// Coletar todas as partidas em uma única lista
+	matches := make([]*entity.Match, 0)
+	for _, phaseMatches := range phases {
+		matches = append(matches, phaseMatches...)
+	}
+
+	if championship.TwoLeggedTies {
+		matches = addSecondLegs(championship, matches)
+	}
+
+	// No sorteio por fase a disputa de terceiro lugar é criada junto com a final
+	if championship.ThirdPlaceMatch && championship.ProgressionType == entity.ProgressionFixed {
+		matches = append(matches, buildThirdPlaceMatch(championship, phases[numPhases-2]))
+	}
+
+	return matches, nil
+}
+
+// buildBracketPhases preenche phases[1:] a partir de phases[0], ligando cada par de partidas
+// consecutivas a uma partida pai na fase seguinte.
+func buildBracketPhases(championship *entity.Championship, phases [][]*entity.Match) {
+	for phase := 1; phase < len(phases); phase++ {
+		previousPhaseMatches := phases[phase-1]
+		numMatches := len(previousPhaseMatches)
+		currentPhaseMatches := make([]*entity.Match, 0)
+		for i := 0; i < numMatches; i += 2 {
+			leftChildMatch := previousPhaseMatches[i]
+			var rightChildMatch *entity.Match
+			if i+1 < numMatches {
+				rightChildMatch = previousPhaseMatches[i+1]
+			} else {
+				rightChildMatch = nil // Bye
 			}
-			phases[phase] = currentPhaseMatches
+			match := &entity.Match{
+				ID:                uuid.New(),
+				ChampionshipID:    championship.ID,
+				LeftChildMatchID:  &leftChildMatch.ID,
+				RightChildMatchID: nil,
+				MatchDate:         nil,
+				Status:            entity.MatchStatusScheduled,
+				Phase:             leftChildMatch.Phase + 1,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			if rightChildMatch != nil {
+				match.RightChildMatchID = &rightChildMatch.ID
+			}
+			// Atualizar o ParentMatchID nas partidas filhas
+			leftChildMatch.ParentMatchID = &match.ID
+			if rightChildMatch != nil {
+				rightChildMatch.ParentMatchID = &match.ID
+			}
+			currentPhaseMatches = append(currentPhaseMatches, match)
 		}
+		phases[phase] = currentPhaseMatches
 	}
+}
 
-	// Coletar todas as partidas em uma única lista
+// validateThirdPlaceSettings garante que a disputa de terceiro lugar só é pedida quando há
+// semifinais.
+func validateThirdPlaceSettings(championship *entity.Championship, numTeams int) error {
+	if !championship.ThirdPlaceMatch {
+		return nil
+	}
+	if championship.Type != entity.ChampionshipTypeCup || championship.DoubleElimination {
+		return errors.New("a disputa de terceiro lugar só é permitida em copas de eliminação simples")
+	}
+	if numTeams < 4 {
+		return fmt.Errorf("a disputa de terceiro lugar exige no mínimo 4 times, recebido %d", numTeams)
+	}
+	return nil
+}
+
+// buildThirdPlaceMatch cria a disputa de terceiro lugar, jogada na fase da final, e faz os
+// perdedores das semifinais serem levados até ela.
+func buildThirdPlaceMatch(championship *entity.Championship, semiFinals []*entity.Match) *entity.Match {
+	match := &entity.Match{
+		ID:             uuid.New(),
+		ChampionshipID: championship.ID,
+		Status:         entity.MatchStatusScheduled,
+		Phase:          semiFinals[0].Phase + 1,
+		Bracket:        entity.MatchBracketThirdPlace,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	for _, semiFinal := range semiFinals {
+		semiFinal.LoserMatchID = &match.ID
+	}
+	return match
+}
+
+// validateGroupsKnockoutSettings garante que a divisão em grupos gera uma chave eliminatória completa.
+func validateGroupsKnockoutSettings(championship *entity.Championship, numTeams int) error {
+	if championship.NumGroups < 1 {
+		return errors.New("o número de grupos deve ser maior que zero")
+	}
+	if championship.QualifiersPerGroup < 1 {
+		return errors.New("o número de classificados por grupo deve ser maior que zero")
+	}
+
+	qualifiers := championship.NumGroups * championship.QualifiersPerGroup
+	if qualifiers < 2 || qualifiers&(qualifiers-1) != 0 {
+		return fmt.Errorf("o total de classificados (%d) deve ser uma potência de 2", qualifiers)
+	}
+
+	smallestGroup := numTeams / championship.NumGroups
+	if smallestGroup < 2 || smallestGroup < championship.QualifiersPerGroup {
+		return fmt.Errorf("%d times não são suficientes para %d grupos com %d classificados cada",
+			numTeams, championship.NumGroups, championship.QualifiersPerGroup)
+	}
+
+	return nil
+}
+
+// splitIntoGroups distribui os times entre os grupos na ordem da lista (1º no grupo 1,
+// 2º no grupo 2, ...), de modo que uma lista ordenada por força funciona como potes.
+func splitIntoGroups(teamIDs []uuid.UUID, numGroups int) [][]uuid.UUID {
+	groups := make([][]uuid.UUID, numGroups)
+	for i, teamID := range teamIDs {
+		groups[i%numGroups] = append(groups[i%numGroups], teamID)
+	}
+	return groups
+}
+
+func (s *matchService) generateGroupsKnockoutMatches(ctx context.Context, championship *entity.Championship, groups [][]uuid.UUID) ([]*entity.Match, error) {
 	matches := make([]*entity.Match, 0)
+
+	// Fase de grupos: todos contra todos dentro de cada grupo
+	for i, group := range groups {
+		groupNumber := i + 1
+		matches = append(matches, generateRoundRobinMatches(championship, group, &groupNumber)...)
+	}
+
+	// Mata-mata: a primeira fase fica sem times até o fim da fase de grupos
+	qualifiers := championship.NumGroups * championship.QualifiersPerGroup
+	numPhases := int(math.Log2(float64(qualifiers)))
+
+	phases := make([][]*entity.Match, numPhases)
+	phases[0] = make([]*entity.Match, 0)
+	for i := 0; i < qualifiers/2; i++ {
+		phases[0] = append(phases[0], &entity.Match{
+			ID:             uuid.New(),
+			ChampionshipID: championship.ID,
+			Status:         entity.MatchStatusScheduled,
+			Phase:          groupStagePhase + 1,
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		})
+	}
+	buildBracketPhases(championship, phases)
+
 	for _, phaseMatches := range phases {
 		matches = append(matches, phaseMatches...)
 	}
@@ -222,7 +456,135 @@ func (s *matchService) generateCupMatches(ctx context.Context, championship *ent
 	return matches, nil
 }
 
-func (s *matchService) UpdateMatchResult(ctx context.Context, matchID uuid.UUID, result MatchResultUpdate) error {
+// bracketSeedOrder devolve os cabeças de chave na ordem dos slots de uma chave com size
+// posições (potência de 2), garantindo que os melhores só se enfrentem nas fases finais.
+// Ex.: 8 -> [1 8 4 5 2 7 3 6].
+func bracketSeedOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		n := len(order) * 2
+		next := make([]int, 0, n)
+		for _, seed := range order {
+			next = append(next, seed, n+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// seedKnockoutFromGroups preenche a primeira fase do mata-mata com os classificados de cada
+// grupo quando todas as partidas da fase de grupos estiverem concluídas.
+func (s *matchService) seedKnockoutFromGroups(ctx context.Context, championship *entity.Championship) (err error) {
+	tx, err := s.matchRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	return s.seedKnockoutFromGroupsWithTx(ctx, tx, championship)
+}
+
+// seedKnockoutFromGroupsWithTx define o mata-mata dentro de uma transação já aberta, com a
+// classificação dos grupos que inclui os resultados gravados nela.
+func (s *matchService) seedKnockoutFromGroupsWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error {
+	matches, err := s.matchRepo.GetByChampionshipIDWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+
+	matchesByID := make(map[uuid.UUID]*entity.Match)
+	var final *entity.Match
+	for _, match := range matches {
+		if match.GroupNumber != nil {
+			if !match.IsCompleted() {
+				return nil // A fase de grupos ainda não terminou
+			}
+			continue
+		}
+		matchesByID[match.ID] = match
+		if match.ParentMatchID == nil {
+			final = match
+		}
+	}
+	if final == nil {
+		return fmt.Errorf("chave eliminatória do campeonato %s não encontrada", championship.ID)
+	}
+
+	firstRound := bracketFirstRound(final, matchesByID, groupStagePhase+1)
+	for _, match := range firstRound {
+		if match.HomeTeamID != nil || match.AwayTeamID != nil {
+			return nil // O mata-mata já foi definido
+		}
+	}
+
+	seeds, err := knockoutSeeds(championship, func(group int) ([]*entity.Statistics, error) {
+		return s.statisticsService.GetStatisticsByGroupWithTx(ctx, tx, championship, group)
+	})
+	if err != nil {
+		return err
+	}
+
+	order := bracketSeedOrder(len(seeds))
+	for i, match := range firstRound {
+		match.HomeTeamID = &seeds[order[2*i]-1]
+		match.AwayTeamID = &seeds[order[2*i+1]-1]
+		match.UpdatedAt = time.Now()
+		if err := s.matchRepo.UpdateWithTx(ctx, tx, match); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// knockoutSeeds ordena os classificados dos grupos como cabeças de chave do mata-mata: todos
+// os 1º colocados, depois todos os 2º colocados, e assim por diante.
+func knockoutSeeds(championship *entity.Championship, groupStandings func(group int) ([]*entity.Statistics, error)) ([]uuid.UUID, error) {
+	seeds := make([]uuid.UUID, championship.NumGroups*championship.QualifiersPerGroup)
+	for group := 1; group <= championship.NumGroups; group++ {
+		standings, err := groupStandings(group)
+		if err != nil {
+			return nil, err
+		}
+		if len(standings) < championship.QualifiersPerGroup {
+			return nil, fmt.Errorf("grupo %d possui menos times do que classificados", group)
+		}
+		for position := 0; position < championship.QualifiersPerGroup; position++ {
+			seeds[position*championship.NumGroups+group-1] = standings[position].TeamID
+		}
+	}
+	return seeds, nil
+}
+
+// bracketFirstRound percorre a chave a partir da final e devolve as partidas da fase
+// firstPhase na ordem dos slots, da esquerda para a direita.
+func bracketFirstRound(root *entity.Match, matchesByID map[uuid.UUID]*entity.Match, firstPhase int) []*entity.Match {
+	if root.Phase <= firstPhase {
+		return []*entity.Match{root}
+	}
+
+	var leaves []*entity.Match
+	for _, childID := range []*uuid.UUID{root.LeftChildMatchID, root.RightChildMatchID} {
+		if childID == nil {
+			continue
+		}
+		if child, ok := matchesByID[*childID]; ok {
+			leaves = append(leaves, bracketFirstRound(child, matchesByID, firstPhase)...)
+		}
+	}
+	return leaves
+}
+
+// UpdateMatchResult grava o resultado da partida e tudo o que depende dele (chave, classificação,
+// números dos jogadores, sorteio da fase seguinte e encerramento do campeonato) em uma única
+// transação, de modo que uma falha em qualquer etapa não deixe o resultado gravado pela metade.
+func (s *matchService) UpdateMatchResult(ctx context.Context, matchID uuid.UUID, result MatchResultUpdate) (err error) {
 	// Iniciar transação
 	tx, err := s.matchRepo.BeginTx(ctx)
 	if err != nil {
@@ -243,63 +605,187 @@ func (s *matchService) UpdateMatchResult(ctx context.Context, matchID uuid.UUID,
 	if match == nil {
 		return fmt.Errorf("partida com ID %s não encontrada", matchID)
 	}
+	if match.Status == entity.MatchStatusBye {
+		return fmt.Errorf("a partida com ID %s é um bye e não recebe resultado", matchID)
+	}
+	if match.Status == entity.MatchStatusFinished {
+		return fmt.Errorf("a partida com ID %s já foi encerrada; use a correção de resultado", matchID)
+	}
+	if !match.CanTransitionTo(entity.MatchStatusFinished) {
+		return fmt.Errorf("a partida com ID %s não pode ser encerrada (status %s)", matchID, match.Status)
+	}
+	if match.HomeTeamID == nil || match.AwayTeamID == nil {
+		return fmt.Errorf("a partida com ID %s ainda não tem os dois times definidos", matchID)
+	}
 
 	// Atualizar o resultado da partida
-	match.ScoreHome = result.ScoreHome
-	match.ScoreAway = result.ScoreAway
-	match.HasExtraTime = result.HasExtraTime
-	if result.HasExtraTime {
-		match.ScoreHomeExtraTime = result.ScoreHomeExtraTime
-		match.ScoreAwayExtraTime = result.ScoreAwayExtraTime
+	applyResult(match, result)
+
+	// O placar lançado deve conferir com os gols registrados nos eventos da partida
+	if !match.Walkover {
+		var events []*entity.MatchEvent
+		events, err = s.matchEventRepo.ListByMatchIDWithTx(ctx, tx, match.ID)
+		if err != nil {
+			return err
+		}
+		if err = scoreFromEvents(match, events).matches(match); err != nil {
+			return err
+		}
 	}
-	match.HasPenalties = result.HasPenalties
-	if result.HasPenalties {
-		match.ScoreHomePenalties = result.ScoreHomePenalties
-		match.ScoreAwayPenalties = result.ScoreAwayPenalties
+
+	championship, err := s.championshipRepo.GetByID(ctx, match.ChampionshipID)
+	if err != nil {
+		return err
 	}
-	match.Status = entity.MatchStatusFinished
-	match.UpdatedAt = time.Now()
+	if championship == nil {
+		return fmt.Errorf("campeonato com ID %s não encontrado", match.ChampionshipID)
+	}
+	if championship.Status != entity.ChampionshipStatusInProgress {
+		return fmt.Errorf("o campeonato com ID %s não está em andamento (status %s)", championship.ID, championship.Status)
+	}
+
+	// Em partidas por pontos (liga, suíço e fase de grupos) o empate é um resultado válido
+	countsForStandings := hasStandings(championship, match)
 
-	// Determinar o time vencedor
-	winnerTeamID, err := s.determineWinner(match)
+	// Determinar o time vencedor. Em confrontos de ida e volta o jogo de ida não tem
+	// vencedor e o confronto é decidido no jogo de volta, que avança pela chave no lugar da ida.
+	var winnerTeamID *uuid.UUID
+	bracketMatch := match
+	switch match.Leg {
+	case 1:
+		// Jogo de ida: apenas o placar é registrado
+	case 2:
+		if match.FirstLegMatchID == nil {
+			return fmt.Errorf("o jogo de volta com ID %s não está ligado a um jogo de ida", matchID)
+		}
+		bracketMatch, err = s.matchRepo.GetByIDWithTx(ctx, tx, *match.FirstLegMatchID)
+		if errors.Is(err, pgx.ErrNoRows) || (err == nil && bracketMatch == nil) {
+			return fmt.Errorf("jogo de ida com ID %s não encontrado", *match.FirstLegMatchID)
+		}
+		if err != nil {
+			return err
+		}
+		if bracketMatch.Status != entity.MatchStatusFinished {
+			return errors.New("o jogo de ida do confronto ainda não foi encerrado")
+		}
+		winnerTeamID, err = determineTieWinner(bracketMatch, match, championship.AwayGoals)
+	default:
+		winnerTeamID, err = s.determineWinner(match, countsForStandings)
+	}
 	if err != nil {
 		return err
 	}
 	match.WinnerTeamID = winnerTeamID
 
 	// Atualizar a partida no banco de dados
-	if err := s.matchRepo.UpdateWithTx(ctx, tx, match); err != nil {
+	if err = s.matchRepo.UpdateWithTx(ctx, tx, match); err != nil {
 		return err
 	}
 
-	// Propagar o vencedor para a próxima fase (apenas para campeonatos do tipo Copa)
-	championship, err := s.championshipRepo.GetByID(ctx, match.ChampionshipID)
-	if err != nil {
-		return err
-	}
-	if championship.Type == entity.ChampionshipTypeCup && match.ParentMatchID != nil {
-		err = s.propagateWinner(ctx, tx, *match.ParentMatchID, *winnerTeamID)
+	// Propagar o vencedor para a próxima fase do mata-mata
+	if !countsForStandings && winnerTeamID != nil && bracketMatch.ParentMatchID != nil {
+		err = s.propagateWinner(ctx, tx, bracketMatch, *winnerTeamID)
 		if err != nil {
 			return err
 		}
 	}
 
-	// Commit da transação de partida
-	if err := tx.Commit(ctx); err != nil {
+	// Levar o perdedor para a chave dos perdedores ou para a disputa de terceiro lugar
+	if winnerTeamID != nil && bracketMatch.LoserMatchID != nil {
+		if loserTeamID := loserOf(bracketMatch, *winnerTeamID); loserTeamID != nil {
+			if err = s.propagateLoser(ctx, tx, bracketMatch, *loserTeamID); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Se o campeão da chave dos perdedores vence a grande final, a decisão vai para uma nova partida
+	if match.Bracket == entity.MatchBracketGrandFinal && championship.BracketReset &&
+		match.AwayTeamID != nil && *winnerTeamID == *match.AwayTeamID {
+		if err = s.createBracketResetWithTx(ctx, tx, match); err != nil {
+			return err
+		}
+	}
+
+	// Os lances da partida passam a contar para os números dos jogadores e a partida é
+	// cumprida pelos suspensos, junto com o resultado
+	if err = s.rebuildPlayerRecordsWithTx(ctx, tx, championship); err != nil {
 		return err
 	}
 
-	// Atualizar estatísticas (fora da transação anterior)
-	if championship.Type == entity.ChampionshipTypeLeague {
-		if err := s.statisticsService.UpdateStatisticsAfterMatch(ctx, match); err != nil {
+	// Atualizar estatísticas
+	if countsForStandings {
+		if err = s.statisticsService.UpdateStatisticsAfterMatchWithTx(ctx, tx, match); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	// No sorteio por fase, encerrada a fase, sortear os confrontos da seguinte
+	if hasDrawnPhases(championship) && match.Bracket == "" {
+		if err = s.drawNextPhaseWithTx(ctx, tx, championship, match.Phase); err != nil {
+			return err
+		}
+	}
+
+	// Encerrada a fase de grupos, definir os confrontos do mata-mata
+	if championship.Type == entity.ChampionshipTypeGroupsKnockout && match.GroupNumber != nil {
+		if err = s.seedKnockoutFromGroupsWithTx(ctx, tx, championship); err != nil {
+			return err
+		}
+	}
+
+	// Os números do mata-mata são refeitos depois do sorteio e da definição dos confrontos,
+	// para que a fase alcançada pelos times já conte com as novas partidas
+	if hasKnockout(championship) {
+		if err = s.statisticsService.RebuildCupStatisticsWithTx(ctx, tx, championship); err != nil {
+			return err
+		}
+	}
+
+	return s.finishChampionshipIfCompletedWithTx(ctx, tx, championship)
+}
+
+// rebuildPlayerRecords recalcula os números e as suspensões dos jogadores do campeonato.
+func (s *matchService) rebuildPlayerRecords(ctx context.Context, championshipID uuid.UUID) error {
+	if err := s.playerStatisticsService.RebuildPlayerStatistics(ctx, championshipID); err != nil {
+		return err
+	}
+	return s.suspensionService.RebuildSuspensions(ctx, championshipID)
+}
+
+// rebuildPlayerRecordsWithTx recalcula os números e as suspensões dos jogadores dentro da
+// transação da partida.
+func (s *matchService) rebuildPlayerRecordsWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error {
+	if err := s.playerStatisticsService.RebuildPlayerStatisticsWithTx(ctx, tx, championship); err != nil {
+		return err
+	}
+	return s.suspensionService.RebuildSuspensionsWithTx(ctx, tx, championship)
+}
+
+// applyResult grava o placar informado na partida e a encerra.
+func applyResult(match *entity.Match, result MatchResultUpdate) {
+	match.ScoreHome = result.ScoreHome
+	match.ScoreAway = result.ScoreAway
+	match.HasExtraTime = result.HasExtraTime
+	match.ScoreHomeExtraTime, match.ScoreAwayExtraTime = 0, 0
+	if result.HasExtraTime {
+		match.ScoreHomeExtraTime = result.ScoreHomeExtraTime
+		match.ScoreAwayExtraTime = result.ScoreAwayExtraTime
+	}
+	match.HasPenalties = result.HasPenalties
+	match.ScoreHomePenalties, match.ScoreAwayPenalties = 0, 0
+	if result.HasPenalties {
+		match.ScoreHomePenalties = result.ScoreHomePenalties
+		match.ScoreAwayPenalties = result.ScoreAwayPenalties
+	}
+	match.Walkover = result.Walkover
+	match.Status = entity.MatchStatusFinished
+	match.UpdatedAt = time.Now()
 }
 
-func (s *matchService) determineWinner(match *entity.Match) (*uuid.UUID, error) {
+// determineWinner devolve o vencedor da partida. Quando allowDraw é verdadeiro, um empate
+// sem disputa de pênaltis devolve nil em vez de erro.
+func (s *matchService) determineWinner(match *entity.Match, allowDraw bool) (*uuid.UUID, error) {
 	var homeGoals, awayGoals int
 
 	homeGoals = match.ScoreHome
@@ -324,13 +810,16 @@ func (s *matchService) determineWinner(match *entity.Match) (*uuid.UUID, error)
 				return nil, errors.New("empate nas penalidades não é permitido")
 			}
 
+		} else if allowDraw {
+			return nil, nil
 		} else {
 			return nil, errors.New("partida terminou empatada e não há penalidades")
 		}
 	}
 }
 
-func (s *matchService) propagateWinner(ctx context.Context, tx pgx.Tx, parentMatchID uuid.UUID, winnerTeamID uuid.UUID) error {
+func (s *matchService) propagateWinner(ctx context.Context, tx pgx.Tx, match *entity.Match, winnerTeamID uuid.UUID) error {
+	parentMatchID := *match.ParentMatchID
 	parentMatch, err := s.matchRepo.GetByIDWithTx(ctx, tx, parentMatchID)
 	if err != nil {
 		return err
@@ -340,12 +829,12 @@ func (s *matchService) propagateWinner(ctx context.Context, tx pgx.Tx, parentMat
 	}
 
 	// Determinar se o vencedor vai para o lado esquerdo ou direito
-	if parentMatch.LeftChildMatchID != nil && *parentMatch.LeftChildMatchID == parentMatchID {
+	if parentMatch.LeftChildMatchID != nil && *parentMatch.LeftChildMatchID == match.ID {
 		parentMatch.HomeTeamID = &winnerTeamID
-	} else if parentMatch.RightChildMatchID != nil && *parentMatch.RightChildMatchID == parentMatchID {
+	} else if parentMatch.RightChildMatchID != nil && *parentMatch.RightChildMatchID == match.ID {
 		parentMatch.AwayTeamID = &winnerTeamID
 	} else {
-		return fmt.Errorf("partida com ID %s não é filha da partida com ID %s", parentMatchID, parentMatch.ID)
+		return fmt.Errorf("partida com ID %s não é filha da partida com ID %s", match.ID, parentMatch.ID)
 	}
 
 	parentMatch.UpdatedAt = time.Now()
@@ -355,9 +844,84 @@ func (s *matchService) propagateWinner(ctx context.Context, tx pgx.Tx, parentMat
 		return err
 	}
 
+	if err := s.syncSecondLegWithTx(ctx, tx, parentMatch); err != nil {
+		return err
+	}
+
+	// O adversário de um time desclassificado avança por W.O.
+	if parentMatch.IsAwaitingWalkover() {
+		return s.completeWalkoverWithTx(ctx, tx, parentMatch)
+	}
 	return nil
 }
 
+// resolveByesWithTx encerra como bye as partidas da chave com apenas um time, declarando-o
+// vencedor e levando-o para a partida pai.
+func (s *matchService) resolveByesWithTx(ctx context.Context, tx pgx.Tx, matches []*entity.Match) error {
+	for _, match := range matches {
+		if match.Phase != 1 || (match.HomeTeamID == nil) == (match.AwayTeamID == nil) {
+			continue
+		}
+
+		winnerTeamID := match.HomeTeamID
+		if winnerTeamID == nil {
+			winnerTeamID = match.AwayTeamID
+		}
+		match.Status = entity.MatchStatusBye
+		match.WinnerTeamID = winnerTeamID
+		match.UpdatedAt = time.Now()
+		if err := s.matchRepo.UpdateWithTx(ctx, tx, match); err != nil {
+			return err
+		}
+
+		if match.ParentMatchID != nil {
+			if err := s.propagateWinner(ctx, tx, match, *winnerTeamID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// propagateLoser leva o perdedor de uma partida para a partida indicada em LoserMatchID
+// (chave dos perdedores ou disputa de terceiro lugar). Quando a partida de destino já recebe
+// um vencedor pela esquerda (LeftChildMatchID), o perdedor joga como visitante; caso
+// contrário ocupa a primeira vaga livre.
+func (s *matchService) propagateLoser(ctx context.Context, tx pgx.Tx, match *entity.Match, loserTeamID uuid.UUID) error {
+	loserMatchID := *match.LoserMatchID
+	loserMatch, err := s.matchRepo.GetByIDWithTx(ctx, tx, loserMatchID)
+	if err != nil {
+		return err
+	}
+	if loserMatch == nil {
+		return fmt.Errorf("partida com ID %s para o perdedor não encontrada", loserMatchID)
+	}
+
+	if loserMatch.LeftChildMatchID != nil || (loserMatch.HomeTeamID != nil && *loserMatch.HomeTeamID != loserTeamID) {
+		loserMatch.AwayTeamID = &loserTeamID
+	} else {
+		loserMatch.HomeTeamID = &loserTeamID
+	}
+	loserMatch.UpdatedAt = time.Now()
+
+	if err := s.matchRepo.UpdateWithTx(ctx, tx, loserMatch); err != nil {
+		return err
+	}
+
+	if loserMatch.IsAwaitingWalkover() {
+		return s.completeWalkoverWithTx(ctx, tx, loserMatch)
+	}
+	return nil
+}
+
+// loserOf devolve o time derrotado de uma partida decidida.
+func loserOf(match *entity.Match, winnerTeamID uuid.UUID) *uuid.UUID {
+	if match.HomeTeamID != nil && *match.HomeTeamID == winnerTeamID {
+		return match.AwayTeamID
+	}
+	return match.HomeTeamID
+}
+
 func (s *matchService) GetMatchByID(ctx context.Context, matchID uuid.UUID) (*entity.Match, error) {
 	match, err := s.matchRepo.GetByID(ctx, matchID)
 	if err != nil {
@@ -376,3 +940,14 @@ func (s *matchService) ListMatchesByChampionship(ctx context.Context, championsh
 	}
 	return matches, nil
 }
+
+func (s *matchService) ListMatchesByRound(ctx context.Context, championshipID uuid.UUID, round int) ([]*entity.Match, error) {
+	if round < 1 {
+		return nil, fmt.Errorf("rodada %d inválida", round)
+	}
+	matches, err := s.matchRepo.GetByRound(ctx, championshipID, round)
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
@@ -0,0 +1,465 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// correctionPlan reúne as alterações de uma correção de resultado, gravadas depois em uma
+// única transação. Quando a correção muda quem avança na chave, as partidas seguintes recebem
+// o novo time; as que já foram disputadas só são anuladas se a correção for em cascata.
+type correctionPlan struct {
+	championship *entity.Championship
+	disqualified map[uuid.UUID]bool // Times desclassificados, que não se classificam no recálculo dos grupos
+	cascade      bool
+	ordered      []*entity.Match
+	matches      map[uuid.UUID]*entity.Match
+	secondLegs   map[uuid.UUID]*entity.Match // Jogo de volta de cada jogo de ida
+	updated      []*entity.Match
+	created      []*entity.Match
+	deleted      []*entity.Match
+	touched      map[uuid.UUID]bool
+}
+
+// CorrectMatchResult substitui o resultado de uma partida já encerrada. O novo vencedor é
+// levado pela chave no lugar do anterior e a classificação é recalculada a partir das
+// partidas. Com cascade falso, a correção é recusada se alguma partida afetada já foi
+// disputada; com cascade verdadeiro, essas partidas são anuladas e voltam a ser agendadas.
+func (s *matchService) CorrectMatchResult(ctx context.Context, matchID uuid.UUID, result MatchResultUpdate, cascade bool) (err error) {
+	tx, err := s.matchRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	match, err := s.matchRepo.GetByIDWithTx(ctx, tx, matchID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && match == nil) {
+		return notFoundf("partida com ID %s não encontrada", matchID)
+	}
+	if err != nil {
+		return err
+	}
+
+	// O placar corrigido deve conferir com os gols registrados nos eventos da partida
+	events, err := s.matchEventRepo.ListByMatchIDWithTx(ctx, tx, match.ID)
+	if err != nil {
+		return err
+	}
+	if err = checkCorrectedScore(match, result, events); err != nil {
+		return err
+	}
+
+	championship, err := s.championshipRepo.GetByID(ctx, match.ChampionshipID)
+	if err != nil {
+		return err
+	}
+	if championship == nil {
+		return notFoundf("campeonato com ID %s não encontrado", match.ChampionshipID)
+	}
+	if championship.Status != entity.ChampionshipStatusInProgress {
+		return conflictf("o campeonato com ID %s não está em andamento (status %s)", championship.ID, championship.Status)
+	}
+
+	matches, err := s.matchRepo.GetByChampionshipIDWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+
+	statsList, err := s.statisticsService.GetStatisticsByChampionship(ctx, championship.ID)
+	if err != nil {
+		return err
+	}
+
+	plan, err := s.planCorrection(championship, matches, disqualifiedTeams(statsList), matchID, result, cascade)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range plan.deleted {
+		if err = s.matchRepo.DeleteWithTx(ctx, tx, m.ID); err != nil {
+			return err
+		}
+	}
+	for _, m := range plan.updated {
+		if err = s.matchRepo.UpdateWithTx(ctx, tx, m); err != nil {
+			return err
+		}
+	}
+	for _, m := range plan.created {
+		if err = s.matchRepo.CreateWithTx(ctx, tx, m); err != nil {
+			return err
+		}
+	}
+
+	// A classificação é refeita a partir das partidas, desfazendo o resultado anterior
+	if hasStandings(championship, match) {
+		if err = s.statisticsService.RebuildStatisticsWithTx(ctx, tx, championship); err != nil {
+			return err
+		}
+	}
+
+	// Partidas anuladas em cascata deixam de contar para os números e as suspensões dos jogadores
+	if err = s.rebuildPlayerRecordsWithTx(ctx, tx, championship); err != nil {
+		return err
+	}
+
+	// Mata-mata anulado pela correção de um jogo de grupo volta a ser definido
+	if championship.Type == entity.ChampionshipTypeGroupsKnockout && match.GroupNumber != nil {
+		if err = s.seedKnockoutFromGroupsWithTx(ctx, tx, championship); err != nil {
+			return err
+		}
+	}
+
+	// Os números do mata-mata acompanham a chave corrigida
+	if hasKnockout(championship) {
+		if err = s.statisticsService.RebuildCupStatisticsWithTx(ctx, tx, championship); err != nil {
+			return err
+		}
+	}
+
+	return s.finishChampionshipIfCompletedWithTx(ctx, tx, championship)
+}
+
+// checkCorrectedScore confere o placar corrigido com os gols registrados, como no lançamento
+// do resultado. Resultados por W.O. não são conferidos.
+func checkCorrectedScore(match *entity.Match, result MatchResultUpdate, events []*entity.MatchEvent) error {
+	if result.Walkover {
+		return nil
+	}
+	corrected := *match
+	applyResult(&corrected, result)
+	return invalid(scoreFromEvents(&corrected, events).matches(&corrected))
+}
+
+// planCorrection aplica a correção sobre as partidas do campeonato em memória e devolve as
+// alterações a gravar.
+func (s *matchService) planCorrection(championship *entity.Championship, matches []*entity.Match, disqualified map[uuid.UUID]bool, matchID uuid.UUID, result MatchResultUpdate, cascade bool) (*correctionPlan, error) {
+	plan := &correctionPlan{
+		championship: championship,
+		disqualified: disqualified,
+		cascade:      cascade,
+		ordered:      matches,
+		matches:      make(map[uuid.UUID]*entity.Match, len(matches)),
+		secondLegs:   make(map[uuid.UUID]*entity.Match),
+		touched:      make(map[uuid.UUID]bool),
+	}
+	for _, m := range matches {
+		plan.matches[m.ID] = m
+		if m.Leg == 2 && m.FirstLegMatchID != nil {
+			plan.secondLegs[*m.FirstLegMatchID] = m
+		}
+	}
+
+	match := plan.matches[matchID]
+	if match == nil {
+		return nil, notFoundf("partida com ID %s não encontrada", matchID)
+	}
+	if match.Status != entity.MatchStatusFinished {
+		return nil, conflictf("a partida com ID %s não está encerrada e não pode ser corrigida", matchID)
+	}
+
+	// Em confrontos de ida e volta o nó da chave é o jogo de ida e o vencedor fica no de volta
+	node := match
+	if match.Leg == 2 {
+		if match.FirstLegMatchID == nil {
+			return nil, notFoundf("o jogo de volta com ID %s não está ligado a um jogo de ida", matchID)
+		}
+		node = plan.matches[*match.FirstLegMatchID]
+		if node == nil {
+			return nil, notFoundf("jogo de ida com ID %s não encontrado", *match.FirstLegMatchID)
+		}
+	}
+	deciding := plan.decidingMatch(node)
+	oldWinner := deciding.WinnerTeamID
+	oldLoser := plan.loserOf(node, oldWinner)
+
+	applyResult(match, result)
+	plan.touch(match)
+
+	countsForStandings := hasStandings(championship, match)
+	newWinner, err := s.tieWinner(championship, node, deciding, countsForStandings)
+	if err != nil {
+		return nil, invalid(err)
+	}
+	deciding.WinnerTeamID = newWinner
+	plan.touch(deciding)
+
+	if countsForStandings {
+		if championship.Type == entity.ChampionshipTypeGroupsKnockout && match.GroupNumber != nil {
+			if err := plan.reseedKnockout(); err != nil {
+				return nil, err
+			}
+		}
+		return plan, nil
+	}
+
+	if sameTeam(oldWinner, newWinner) {
+		return plan, nil
+	}
+	if err := plan.moveTeams(node, newWinner, oldLoser, plan.loserOf(node, newWinner)); err != nil {
+		return nil, err
+	}
+	if err := plan.reconcileBracketReset(); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// tieWinner decide o confronto do nó da chave. O jogo de ida sozinho não tem vencedor.
+func (s *matchService) tieWinner(championship *entity.Championship, node, deciding *entity.Match, allowDraw bool) (*uuid.UUID, error) {
+	if node.Leg != 1 {
+		return s.determineWinner(node, allowDraw)
+	}
+	if deciding == node || deciding.Status != entity.MatchStatusFinished {
+		return nil, nil
+	}
+	return determineTieWinner(node, deciding, championship.AwayGoals)
+}
+
+// decidingMatch devolve a partida que guarda o vencedor do confronto: o jogo de volta, se
+// houver, ou o próprio nó da chave.
+func (p *correctionPlan) decidingMatch(node *entity.Match) *entity.Match {
+	if secondLeg, ok := p.secondLegs[node.ID]; ok {
+		return secondLeg
+	}
+	return node
+}
+
+func (p *correctionPlan) loserOf(node *entity.Match, winnerTeamID *uuid.UUID) *uuid.UUID {
+	if winnerTeamID == nil {
+		return nil
+	}
+	return loserOf(node, *winnerTeamID)
+}
+
+func (p *correctionPlan) touch(match *entity.Match) {
+	match.UpdatedAt = time.Now()
+	if !p.touched[match.ID] {
+		p.touched[match.ID] = true
+		p.updated = append(p.updated, match)
+	}
+}
+
+// moveTeams leva o vencedor para a partida pai e o perdedor para a partida de LoserMatchID,
+// no lugar dos times que o confronto havia mandado antes.
+func (p *correctionPlan) moveTeams(node *entity.Match, winner, oldLoser, newLoser *uuid.UUID) error {
+	if node.ParentMatchID != nil {
+		parent := p.matches[*node.ParentMatchID]
+		if parent == nil {
+			return fmt.Errorf("partida pai com ID %s não encontrada", *node.ParentMatchID)
+		}
+		slot := &parent.AwayTeamID
+		if parent.LeftChildMatchID != nil && *parent.LeftChildMatchID == node.ID {
+			slot = &parent.HomeTeamID
+		}
+		if err := p.setTeam(parent, slot, winner); err != nil {
+			return err
+		}
+	}
+
+	if node.LoserMatchID != nil && !sameTeam(oldLoser, newLoser) {
+		loserMatch := p.matches[*node.LoserMatchID]
+		if loserMatch == nil {
+			return fmt.Errorf("partida com ID %s para o perdedor não encontrada", *node.LoserMatchID)
+		}
+		var slot **uuid.UUID
+		switch {
+		case oldLoser != nil && sameTeam(loserMatch.HomeTeamID, oldLoser):
+			slot = &loserMatch.HomeTeamID
+		case oldLoser != nil && sameTeam(loserMatch.AwayTeamID, oldLoser):
+			slot = &loserMatch.AwayTeamID
+		case loserMatch.LeftChildMatchID != nil || loserMatch.HomeTeamID != nil:
+			slot = &loserMatch.AwayTeamID
+		default:
+			slot = &loserMatch.HomeTeamID
+		}
+		if err := p.setTeam(loserMatch, slot, newLoser); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setTeam troca um dos times do confronto. Se o confronto já começou, seu resultado deixa
+// de valer e ele é anulado, o que só é permitido na correção em cascata.
+func (p *correctionPlan) setTeam(node *entity.Match, slot **uuid.UUID, teamID *uuid.UUID) error {
+	if sameTeam(*slot, teamID) {
+		return nil
+	}
+
+	if p.tieStarted(node) {
+		if !p.cascade {
+			return conflictf("a partida com ID %s já foi disputada e seria afetada pela correção; confirme a correção em cascata para anulá-la", node.ID)
+		}
+		if err := p.void(node); err != nil {
+			return err
+		}
+	}
+
+	*slot = teamID
+	p.touch(node)
+
+	if secondLeg, ok := p.secondLegs[node.ID]; ok {
+		secondLeg.HomeTeamID = node.AwayTeamID
+		secondLeg.AwayTeamID = node.HomeTeamID
+		p.touch(secondLeg)
+	}
+	return nil
+}
+
+func (p *correctionPlan) tieStarted(node *entity.Match) bool {
+	return node.Status != entity.MatchStatusScheduled || p.decidingMatch(node).Status != entity.MatchStatusScheduled
+}
+
+// void anula o resultado do confronto e retira da chave os times que ele havia mandado adiante.
+func (p *correctionPlan) void(node *entity.Match) error {
+	deciding := p.decidingMatch(node)
+	oldWinner := deciding.WinnerTeamID
+	oldLoser := p.loserOf(node, oldWinner)
+
+	clearResult(node)
+	p.touch(node)
+	if deciding != node {
+		clearResult(deciding)
+		p.touch(deciding)
+	}
+
+	if oldWinner == nil {
+		return nil
+	}
+	return p.moveTeams(node, nil, oldLoser, nil)
+}
+
+// clearResult devolve a partida ao estado de agendada, sem placar.
+func clearResult(match *entity.Match) {
+	match.Status = entity.MatchStatusScheduled
+	match.ScoreHome, match.ScoreAway = 0, 0
+	match.HasExtraTime = false
+	match.ScoreHomeExtraTime, match.ScoreAwayExtraTime = 0, 0
+	match.HasPenalties = false
+	match.ScoreHomePenalties, match.ScoreAwayPenalties = 0, 0
+	match.WinnerTeamID = nil
+	match.Walkover = false
+}
+
+// reconcileBracketReset cria ou remove a partida extra da grande final conforme o novo
+// vencedor: ela só existe quando o campeão da chave dos perdedores vence a grande final.
+func (p *correctionPlan) reconcileBracketReset() error {
+	if !p.championship.BracketReset {
+		return nil
+	}
+
+	var grandFinal, reset *entity.Match
+	for _, m := range p.ordered {
+		switch m.Bracket {
+		case entity.MatchBracketGrandFinal:
+			grandFinal = m
+		case entity.MatchBracketReset:
+			reset = m
+		}
+	}
+	if grandFinal == nil {
+		return nil
+	}
+
+	needed := grandFinal.Status == entity.MatchStatusFinished && grandFinal.AwayTeamID != nil &&
+		sameTeam(grandFinal.WinnerTeamID, grandFinal.AwayTeamID)
+	switch {
+	case reset == nil && needed:
+		p.created = append(p.created, newBracketResetMatch(grandFinal))
+	case reset != nil && !needed:
+		if reset.Status != entity.MatchStatusScheduled && !p.cascade {
+			return conflictf("a partida com ID %s já foi disputada e seria afetada pela correção; confirme a correção em cascata para anulá-la", reset.ID)
+		}
+		p.deleted = append(p.deleted, reset)
+		if p.touched[reset.ID] {
+			// A partida removida não precisa mais ser atualizada
+			for i, m := range p.updated {
+				if m.ID == reset.ID {
+					p.updated = append(p.updated[:i], p.updated[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// reseedKnockout refaz a primeira fase do mata-mata com a classificação dos grupos calculada
+// a partir das partidas corrigidas, caso o mata-mata já tenha sido definido.
+func (p *correctionPlan) reseedKnockout() error {
+	var final *entity.Match
+	knockout := make(map[uuid.UUID]*entity.Match)
+	for _, m := range p.ordered {
+		if m.GroupNumber != nil {
+			if !m.IsCompleted() {
+				return nil // A fase de grupos ainda não terminou
+			}
+			continue
+		}
+		knockout[m.ID] = m
+		if m.ParentMatchID == nil {
+			final = m
+		}
+	}
+	if final == nil {
+		return nil
+	}
+
+	firstRound := bracketFirstRound(final, knockout, groupStagePhase+1)
+	seeded := false
+	for _, m := range firstRound {
+		if m.HomeTeamID != nil || m.AwayTeamID != nil {
+			seeded = true
+		}
+	}
+	if !seeded {
+		return nil
+	}
+
+	standings := computeStandings(p.championship, p.ordered, p.disqualified)
+	seeds, err := knockoutSeeds(p.championship, func(group int) ([]*entity.Statistics, error) {
+		var groupStandings []*entity.Statistics
+		for _, stats := range standings {
+			if stats.GroupNumber != nil && *stats.GroupNumber == group {
+				groupStandings = append(groupStandings, stats)
+			}
+		}
+		return groupStandings, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	order := bracketSeedOrder(len(seeds))
+	for i, m := range firstRound {
+		if err := p.setTeam(m, &m.HomeTeamID, &seeds[order[2*i]-1]); err != nil {
+			return err
+		}
+		if err := p.setTeam(m, &m.AwayTeamID, &seeds[order[2*i+1]-1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sameTeam(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// validateDoubleEliminationSettings garante que a chave de dupla eliminação pode ser montada
+// sem byes: a chave dos perdedores só fecha com um número de times potência de 2.
+func validateDoubleEliminationSettings(championship *entity.Championship, numTeams int) error {
+	if !championship.DoubleElimination {
+		if championship.BracketReset {
+			return errors.New("a final de desempate só é permitida em campeonatos de dupla eliminação")
+		}
+		return nil
+	}
+	if championship.Type != entity.ChampionshipTypeCup {
+		return errors.New("a dupla eliminação só é permitida em campeonatos do tipo copa")
+	}
+	if numTeams < 4 || numTeams&(numTeams-1) != 0 {
+		return fmt.Errorf("a dupla eliminação exige um número de times potência de 2 e no mínimo 4, recebido %d", numTeams)
+	}
+	return nil
+}
+
+// generateDoubleEliminationMatches monta as chaves de vencedores e perdedores e a grande final.
+// Com n = 2^k times, a chave dos vencedores tem k fases e a dos perdedores 2(k-1): nas fases
+// ímpares os sobreviventes da chave dos perdedores se enfrentam e nas pares recebem os
+// eliminados da fase seguinte da chave dos vencedores. A chave é sempre montada por inteiro;
+// o sorteio (random_draw) afeta apenas os confrontos iniciais dos times sem cabeça de chave.
+func generateDoubleEliminationMatches(championship *entity.Championship, teamIDs []uuid.UUID) []*entity.Match {
+	numTeams := len(teamIDs)
+	numRounds := 0
+	for size := 1; size < numTeams; size *= 2 {
+		numRounds++
+	}
+
+	slots := seededSlots(championship, teamIDs, numTeams)
+
+	// Chave dos vencedores
+	winners := make([][]*entity.Match, numRounds)
+	for i := 0; i < numTeams; i += 2 {
+		winners[0] = append(winners[0], newBracketMatch(championship, 1, entity.MatchBracketWinners))
+		winners[0][i/2].HomeTeamID = slots[i]
+		winners[0][i/2].AwayTeamID = slots[i+1]
+	}
+	buildBracketPhases(championship, winners)
+	for _, roundMatches := range winners[1:] {
+		for _, match := range roundMatches {
+			match.Bracket = entity.MatchBracketWinners
+		}
+	}
+
+	// Chave dos perdedores
+	numLoserRounds := 2 * (numRounds - 1)
+	losers := make([][]*entity.Match, numLoserRounds)
+	for i := 0; i < len(winners[0]); i += 2 {
+		match := newBracketMatch(championship, 1, entity.MatchBracketLosers)
+		winners[0][i].LoserMatchID = &match.ID
+		winners[0][i+1].LoserMatchID = &match.ID
+		losers[0] = append(losers[0], match)
+	}
+	for round := 2; round <= numLoserRounds; round++ {
+		previous := losers[round-2]
+		if round%2 == 0 {
+			// Sobreviventes da chave dos perdedores contra os eliminados da chave dos vencedores.
+			// A ordem dos eliminados é invertida em fases alternadas para evitar revanches imediatas.
+			dropping := winners[round/2]
+			for i, child := range previous {
+				match := newBracketMatch(championship, round, entity.MatchBracketLosers)
+				match.LeftChildMatchID = &child.ID
+				child.ParentMatchID = &match.ID
+
+				source := dropping[i]
+				if (round/2)%2 == 1 {
+					source = dropping[len(dropping)-1-i]
+				}
+				source.LoserMatchID = &match.ID
+				losers[round-1] = append(losers[round-1], match)
+			}
+			continue
+		}
+		for i := 0; i < len(previous); i += 2 {
+			match := newBracketMatch(championship, round, entity.MatchBracketLosers)
+			match.LeftChildMatchID = &previous[i].ID
+			match.RightChildMatchID = &previous[i+1].ID
+			previous[i].ParentMatchID = &match.ID
+			previous[i+1].ParentMatchID = &match.ID
+			losers[round-1] = append(losers[round-1], match)
+		}
+	}
+
+	// Grande final: campeão da chave dos vencedores (mandante) contra o da chave dos perdedores
+	winnersFinal := winners[numRounds-1][0]
+	losersFinal := losers[numLoserRounds-1][0]
+	grandFinal := newBracketMatch(championship, numLoserRounds+1, entity.MatchBracketGrandFinal)
+	grandFinal.LeftChildMatchID = &winnersFinal.ID
+	grandFinal.RightChildMatchID = &losersFinal.ID
+	winnersFinal.ParentMatchID = &grandFinal.ID
+	losersFinal.ParentMatchID = &grandFinal.ID
+
+	matches := make([]*entity.Match, 0)
+	for _, roundMatches := range winners {
+		matches = append(matches, roundMatches...)
+	}
+	for _, roundMatches := range losers {
+		matches = append(matches, roundMatches...)
+	}
+	return append(matches, grandFinal)
+}
+
+func newBracketMatch(championship *entity.Championship, phase int, bracket entity.MatchBracket) *entity.Match {
+	return &entity.Match{
+		ID:             uuid.New(),
+		ChampionshipID: championship.ID,
+		Status:         entity.MatchStatusScheduled,
+		Phase:          phase,
+		Bracket:        bracket,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+}
+
+// createBracketResetWithTx cria a final de desempate quando o campeão da chave dos perdedores
+// vence a grande final, já que só então o campeão da chave dos vencedores sofre sua primeira derrota.
+func (s *matchService) createBracketResetWithTx(ctx context.Context, tx pgx.Tx, grandFinal *entity.Match) error {
+	matches, err := s.matchRepo.GetByChampionshipID(ctx, grandFinal.ChampionshipID)
+	if err != nil {
+		return err
+	}
+	for _, match := range matches {
+		if match.Bracket == entity.MatchBracketReset {
+			return nil
+		}
+	}
+
+	return s.matchRepo.CreateWithTx(ctx, tx, newBracketResetMatch(grandFinal))
+}
+
+// newBracketResetMatch monta a partida extra entre os finalistas da grande final.
+func newBracketResetMatch(grandFinal *entity.Match) *entity.Match {
+	now := time.Now()
+	return &entity.Match{
+		ID:             uuid.New(),
+		ChampionshipID: grandFinal.ChampionshipID,
+		HomeTeamID:     grandFinal.HomeTeamID,
+		AwayTeamID:     grandFinal.AwayTeamID,
+		Status:         entity.MatchStatusScheduled,
+		Phase:          grandFinal.Phase + 1,
+		Bracket:        entity.MatchBracketReset,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
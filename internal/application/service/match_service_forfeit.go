@@ -0,0 +1,295 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// AwardForfeit encerra por W.O. uma partida ainda não disputada, dando a vitória ao time indicado.
+func (s *matchService) AwardForfeit(ctx context.Context, matchID uuid.UUID, award MatchAward) error {
+	match, err := s.getMatch(ctx, matchID)
+	if err != nil {
+		return err
+	}
+
+	result, err := awardResult(match, award)
+	if err != nil {
+		return err
+	}
+	return s.UpdateMatchResult(ctx, matchID, result)
+}
+
+// DisqualifyTeam desclassifica o time do campeonato. Nas partidas por pontos vale a regra do
+// campeonato: as partidas do time são anuladas ou as pendentes são dadas aos adversários por
+// W.O. No mata-mata os adversários avançam por W.O., inclusive os que ainda serão definidos.
+// O time passa para o fim da classificação. Pode ser repetida para tratar partidas criadas
+// depois da desclassificação, como as de uma fase sorteada.
+func (s *matchService) DisqualifyTeam(ctx context.Context, championshipID, teamID uuid.UUID) error {
+	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
+	if err != nil {
+		return err
+	}
+	if championship == nil {
+		return fmt.Errorf("campeonato com ID %s não encontrado", championshipID)
+	}
+	if championship.Status != entity.ChampionshipStatusInProgress {
+		return fmt.Errorf("o campeonato com ID %s não está em andamento (status %s)", championshipID, championship.Status)
+	}
+
+	matches, err := s.matchRepo.GetByChampionshipID(ctx, championshipID)
+	if err != nil {
+		return err
+	}
+	plays := false
+	for _, match := range matches {
+		if hasTeam(match, teamID) {
+			plays = true
+			break
+		}
+	}
+	if !plays {
+		return fmt.Errorf("o time com ID %s não disputa o campeonato com ID %s", teamID, championshipID)
+	}
+
+	// A classificação marcada antes das partidas garante que o time não se classifique para o
+	// mata-mata nem seja emparelhado no suíço
+	if err := s.statisticsService.DisqualifyTeam(ctx, championshipID, teamID); err != nil {
+		return err
+	}
+
+	if pointsStageOpen(championship, matches) {
+		if championship.Disqualification() == entity.DisqualificationVoid {
+			err = s.voidTeamMatches(ctx, championship, matches, teamID)
+		} else {
+			err = s.forfeitTeamMatches(ctx, championship, matches, teamID, true)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := s.forfeitKnockoutMatches(ctx, championship, teamID); err != nil {
+		return err
+	}
+	if hasKnockout(championship) {
+		if err := s.statisticsService.RebuildCupStatistics(ctx, championship.ID); err != nil {
+			return err
+		}
+	}
+
+	return s.finishChampionshipIfCompleted(ctx, championship)
+}
+
+// pointsStageOpen indica se ainda há partidas por pontos a serem afetadas pela desclassificação.
+// Encerrada a fase de grupos, os resultados dela já definiram o mata-mata e não mudam mais.
+func pointsStageOpen(championship *entity.Championship, matches []*entity.Match) bool {
+	if championship.Type != entity.ChampionshipTypeGroupsKnockout {
+		return true
+	}
+	for _, match := range matches {
+		if match.GroupNumber != nil && !match.IsCompleted() {
+			return true
+		}
+	}
+	return false
+}
+
+// voidTeamMatches cancela todas as partidas por pontos do time, inclusive as já disputadas, e
+// recalcula a classificação sem elas.
+func (s *matchService) voidTeamMatches(ctx context.Context, championship *entity.Championship, matches []*entity.Match, teamID uuid.UUID) (err error) {
+	tx, err := s.matchRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	for _, match := range matches {
+		// Folgas do suíço não têm adversário a ser afetado
+		if !hasStandings(championship, match) || !hasTeam(match, teamID) || match.AwayTeamID == nil ||
+			match.Status == entity.MatchStatusCancelled {
+			continue
+		}
+
+		clearResult(match)
+		match.Status = entity.MatchStatusCancelled
+		match.UpdatedAt = time.Now()
+		if err = s.matchRepo.UpdateWithTx(ctx, tx, match); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	if err = s.statisticsService.RebuildStatistics(ctx, championship.ID); err != nil {
+		return err
+	}
+	if err = s.rebuildPlayerRecords(ctx, championship.ID); err != nil {
+		return err
+	}
+
+	if championship.Type == entity.ChampionshipTypeGroupsKnockout {
+		return s.seedKnockoutFromGroups(ctx, championship)
+	}
+	return nil
+}
+
+// forfeitTeamMatches dá aos adversários, por W.O., as partidas pendentes do time que já têm os
+// dois times definidos. points escolhe entre as partidas por pontos e as do mata-mata.
+func (s *matchService) forfeitTeamMatches(ctx context.Context, championship *entity.Championship, matches []*entity.Match, teamID uuid.UUID, points bool) error {
+	for _, match := range matches {
+		if hasStandings(championship, match) != points || !hasTeam(match, teamID) || match.IsCompleted() ||
+			match.HomeTeamID == nil || match.AwayTeamID == nil {
+			continue
+		}
+
+		opponent := *match.HomeTeamID
+		if opponent == teamID {
+			opponent = *match.AwayTeamID
+		}
+		result, err := awardResult(match, MatchAward{WinnerTeamID: opponent})
+		if err != nil {
+			return err
+		}
+		if err := s.UpdateMatchResult(ctx, match.ID, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// forfeitKnockoutMatches tira o time das partidas pendentes do mata-mata. Cada W.O. pode levar
+// o time para outra partida (chave dos perdedores, disputa de terceiro lugar, partida extra da
+// grande final), por isso as partidas são relidas até que nenhuma pendente o contenha.
+func (s *matchService) forfeitKnockoutMatches(ctx context.Context, championship *entity.Championship, teamID uuid.UUID) error {
+	for {
+		matches, err := s.matchRepo.GetByChampionshipID(ctx, championship.ID)
+		if err != nil {
+			return err
+		}
+
+		match := nextForfeit(championship, matches, teamID)
+		if match == nil {
+			return nil
+		}
+
+		if match.HomeTeamID != nil && match.AwayTeamID != nil {
+			err = s.forfeitTeamMatches(ctx, championship, []*entity.Match{match}, teamID, false)
+		} else {
+			err = s.withdrawFromMatch(ctx, match, teamID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// nextForfeit devolve a próxima partida pendente do mata-mata com o time. O jogo de volta só é
+// decidido depois do jogo de ida.
+func nextForfeit(championship *entity.Championship, matches []*entity.Match, teamID uuid.UUID) *entity.Match {
+	var secondLeg *entity.Match
+	for _, match := range matches {
+		if hasStandings(championship, match) || match.IsCompleted() || !hasTeam(match, teamID) {
+			continue
+		}
+		if match.Leg != 2 {
+			return match
+		}
+		secondLeg = match
+	}
+	return secondLeg
+}
+
+// withdrawFromMatch tira o time de uma partida cujo adversário ainda não foi definido. A
+// partida passa a esperar o adversário, que avança por W.O. assim que chegar.
+func (s *matchService) withdrawFromMatch(ctx context.Context, match *entity.Match, teamID uuid.UUID) (err error) {
+	tx, err := s.matchRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	if match.HomeTeamID != nil && *match.HomeTeamID == teamID {
+		match.HomeTeamID = nil
+	}
+	if match.AwayTeamID != nil && *match.AwayTeamID == teamID {
+		match.AwayTeamID = nil
+	}
+	match.Walkover = true
+	match.UpdatedAt = time.Now()
+
+	if err = s.matchRepo.UpdateWithTx(ctx, tx, match); err != nil {
+		return err
+	}
+	if err = s.syncSecondLegWithTx(ctx, tx, match); err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	return err
+}
+
+// completeWalkoverWithTx encerra por W.O. a partida que esperava o adversário de um time
+// desclassificado e leva o time que chegou adiante na chave. Em confrontos de ida e volta os
+// dois jogos são encerrados.
+func (s *matchService) completeWalkoverWithTx(ctx context.Context, tx pgx.Tx, match *entity.Match) error {
+	winnerTeamID := match.HomeTeamID
+	if winnerTeamID == nil {
+		winnerTeamID = match.AwayTeamID
+	}
+	winner := *winnerTeamID
+
+	result, err := awardResult(match, MatchAward{WinnerTeamID: winner})
+	if err != nil {
+		return err
+	}
+	applyResult(match, result)
+	match.WinnerTeamID = &winner
+	if err := s.matchRepo.UpdateWithTx(ctx, tx, match); err != nil {
+		return err
+	}
+
+	if match.Leg == 1 {
+		secondLeg, err := s.matchRepo.GetSecondLegWithTx(ctx, tx, match.ID)
+		if err != nil {
+			return err
+		}
+		if secondLeg != nil {
+			secondLeg.HomeTeamID, secondLeg.AwayTeamID = match.AwayTeamID, match.HomeTeamID
+			result, err := awardResult(secondLeg, MatchAward{WinnerTeamID: winner})
+			if err != nil {
+				return err
+			}
+			applyResult(secondLeg, result)
+			secondLeg.WinnerTeamID = &winner
+			if err := s.matchRepo.UpdateWithTx(ctx, tx, secondLeg); err != nil {
+				return err
+			}
+		}
+	}
+
+	if match.ParentMatchID != nil {
+		return s.propagateWinner(ctx, tx, match, winner)
+	}
+	return nil
+}
+
+// hasTeam indica se o time disputa a partida.
+func hasTeam(match *entity.Match, teamID uuid.UUID) bool {
+	return (match.HomeTeamID != nil && *match.HomeTeamID == teamID) ||
+		(match.AwayTeamID != nil && *match.AwayTeamID == teamID)
+}
@@ -52,8 +52,10 @@ func TestEndToEndChampionshipCreation(t *testing.T) {
 
 	// Inicializar repositórios
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
 	userRepo := repository.NewUserRepositoryPg(pool)
 	statisticsRepo := repository.NewStatisticsRepositoryPg(pool)
 
@@ -62,10 +64,12 @@ func TestEndToEndChampionshipCreation(t *testing.T) {
 	require.NoError(t, err)
 
 	// Inicializar serviços
-	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo)
+	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo, matchRepo, repository.NewCupStatisticsRepositoryPg(pool), repository.NewStandingsSnapshotRepositoryPg(pool))
 
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
-	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, statisticsService)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
+	playerStatisticsService := service.NewPlayerStatisticsService(repository.NewPlayerStatisticsRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo, repository.NewLineupRepositoryPg(pool), repository.NewPlayerRepositoryPg(pool))
+	suspensionService := service.NewSuspensionService(repository.NewSuspensionRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo)
+	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, matchEventRepo, statisticsService, playerStatisticsService, suspensionService)
 
 	// Iniciar consumidor
 	rabbitMQConsumer, err := messaging.NewRabbitMQConsumer(rabbitConn, "championship_created_test", matchService)
@@ -135,6 +139,10 @@ func TestEndToEndChampionshipCreation(t *testing.T) {
 	err = championshipService.CreateChampionship(ctx, championship, teamIDs)
 	require.NoError(t, err)
 
+	// As partidas só são geradas no início do campeonato
+	err = championshipService.StartChampionship(ctx, championship.ID)
+	require.NoError(t, err)
+
 	// Aguardar um tempo para o consumidor processar a mensagem
 	time.Sleep(5 * time.Second)
 
@@ -175,18 +183,22 @@ func TestEndToEndLeagueChampionshipCreation(t *testing.T) {
 
 	// Inicializar repositórios e serviços (mesmo que antes)
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
 	userRepo := repository.NewUserRepositoryPg(pool)
 	statisticsRepo := repository.NewStatisticsRepositoryPg(pool)
 
 	messagePublisher, err := messaging.NewRabbitMQPublisher(rabbitConn, "championship_created_test")
 	require.NoError(t, err)
 
-	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo)
+	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo, matchRepo, repository.NewCupStatisticsRepositoryPg(pool), repository.NewStandingsSnapshotRepositoryPg(pool))
 
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
-	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, statisticsService)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
+	playerStatisticsService := service.NewPlayerStatisticsService(repository.NewPlayerStatisticsRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo, repository.NewLineupRepositoryPg(pool), repository.NewPlayerRepositoryPg(pool))
+	suspensionService := service.NewSuspensionService(repository.NewSuspensionRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo)
+	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, matchEventRepo, statisticsService, playerStatisticsService, suspensionService)
 
 	rabbitMQConsumer, err := messaging.NewRabbitMQConsumer(rabbitConn, "championship_created_test", matchService)
 	require.NoError(t, err)
@@ -264,6 +276,10 @@ func TestEndToEndLeagueChampionshipCreation(t *testing.T) {
 	err = championshipService.CreateChampionship(ctx, championship, teamIDs)
 	require.NoError(t, err)
 
+	// As partidas só são geradas no início do campeonato
+	err = championshipService.StartChampionship(ctx, championship.ID)
+	require.NoError(t, err)
+
 	// Aguardar um tempo para o consumidor processar a mensagem
 	time.Sleep(5 * time.Second)
 
@@ -276,6 +292,11 @@ func TestEndToEndLeagueChampionshipCreation(t *testing.T) {
 	expectedNumberOfMatches := 6
 	assert.Equal(t, expectedNumberOfMatches, len(matches), "Número de partidas geradas incorreto")
 
+	// A classificação é criada junto com as partidas
+	statsList, err := statisticsRepo.ListByChampionship(ctx, championship.ID)
+	require.NoError(t, err)
+	assert.Len(t, statsList, len(teamIDs))
+
 	// Verificar se cada combinação única de times está presente
 	combinations := make(map[string]bool)
 	for _, match := range matches {
@@ -327,18 +348,22 @@ func TestEndToEndCupChampionshipRandomDraw(t *testing.T) {
 
 	// Inicializar repositórios e serviços (mesmo que antes)
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
 	userRepo := repository.NewUserRepositoryPg(pool)
 	statisticsRepo := repository.NewStatisticsRepositoryPg(pool)
 
 	messagePublisher, err := messaging.NewRabbitMQPublisher(rabbitConn, "championship_created_test")
 	require.NoError(t, err)
 
-	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo)
+	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo, matchRepo, repository.NewCupStatisticsRepositoryPg(pool), repository.NewStandingsSnapshotRepositoryPg(pool))
 
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
-	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, statisticsService)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
+	playerStatisticsService := service.NewPlayerStatisticsService(repository.NewPlayerStatisticsRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo, repository.NewLineupRepositoryPg(pool), repository.NewPlayerRepositoryPg(pool))
+	suspensionService := service.NewSuspensionService(repository.NewSuspensionRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo)
+	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, matchEventRepo, statisticsService, playerStatisticsService, suspensionService)
 
 	rabbitMQConsumer, err := messaging.NewRabbitMQConsumer(rabbitConn, "championship_created_test", matchService)
 	require.NoError(t, err)
@@ -395,6 +420,10 @@ func TestEndToEndCupChampionshipRandomDraw(t *testing.T) {
 	err = championshipService.CreateChampionship(ctx, championship, teamIDs)
 	require.NoError(t, err)
 
+	// As partidas só são geradas no início do campeonato
+	err = championshipService.StartChampionship(ctx, championship.ID)
+	require.NoError(t, err)
+
 	// Aguardar um tempo para o consumidor processar a mensagem
 	time.Sleep(5 * time.Second)
 
@@ -443,8 +472,10 @@ func TestEndToEndLeagueChampionshipWithEightTeams(t *testing.T) {
 
 	// Inicializar repositórios
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
 	userRepo := repository.NewUserRepositoryPg(pool)
 	statisticsRepo := repository.NewStatisticsRepositoryPg(pool)
 
@@ -453,9 +484,11 @@ func TestEndToEndLeagueChampionshipWithEightTeams(t *testing.T) {
 	require.NoError(t, err)
 
 	// Inicializar serviços
-	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo)
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
-	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, statisticsService)
+	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo, matchRepo, repository.NewCupStatisticsRepositoryPg(pool), repository.NewStandingsSnapshotRepositoryPg(pool))
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
+	playerStatisticsService := service.NewPlayerStatisticsService(repository.NewPlayerStatisticsRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo, repository.NewLineupRepositoryPg(pool), repository.NewPlayerRepositoryPg(pool))
+	suspensionService := service.NewSuspensionService(repository.NewSuspensionRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo)
+	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, matchEventRepo, statisticsService, playerStatisticsService, suspensionService)
 
 	// Iniciar consumidor
 	rabbitMQConsumer, err := messaging.NewRabbitMQConsumer(rabbitConn, "championship_created_test", matchService)
@@ -513,6 +546,10 @@ func TestEndToEndLeagueChampionshipWithEightTeams(t *testing.T) {
 	err = championshipService.CreateChampionship(ctx, championship, teamIDs)
 	require.NoError(t, err)
 
+	// As partidas só são geradas no início do campeonato
+	err = championshipService.StartChampionship(ctx, championship.ID)
+	require.NoError(t, err)
+
 	// Aguardar processamento
 	time.Sleep(3 * time.Second)
 
@@ -552,8 +589,10 @@ func TestEndToEndCupChampionshipWithEightTeams(t *testing.T) {
 
 	// Inicializar repositórios
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
 	userRepo := repository.NewUserRepositoryPg(pool)
 	statisticsRepo := repository.NewStatisticsRepositoryPg(pool)
 
@@ -562,9 +601,11 @@ func TestEndToEndCupChampionshipWithEightTeams(t *testing.T) {
 	require.NoError(t, err)
 
 	// Inicializar serviços
-	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo)
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
-	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, statisticsService)
+	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo, matchRepo, repository.NewCupStatisticsRepositoryPg(pool), repository.NewStandingsSnapshotRepositoryPg(pool))
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
+	playerStatisticsService := service.NewPlayerStatisticsService(repository.NewPlayerStatisticsRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo, repository.NewLineupRepositoryPg(pool), repository.NewPlayerRepositoryPg(pool))
+	suspensionService := service.NewSuspensionService(repository.NewSuspensionRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo)
+	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, matchEventRepo, statisticsService, playerStatisticsService, suspensionService)
 
 	// Iniciar consumidor
 	rabbitMQConsumer, err := messaging.NewRabbitMQConsumer(rabbitConn, "championship_created_test", matchService)
@@ -622,6 +663,10 @@ func TestEndToEndCupChampionshipWithEightTeams(t *testing.T) {
 	err = championshipService.CreateChampionship(ctx, championship, teamIDs)
 	require.NoError(t, err)
 
+	// As partidas só são geradas no início do campeonato
+	err = championshipService.StartChampionship(ctx, championship.ID)
+	require.NoError(t, err)
+
 	// Aguardar processamento
 	time.Sleep(3 * time.Second)
 
@@ -661,8 +706,10 @@ func TestEndToEndChampionshipCreationWithInvalidData(t *testing.T) {
 
 	// Inicializar repositórios
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
 	userRepo := repository.NewUserRepositoryPg(pool)
 	statisticsRepo := repository.NewStatisticsRepositoryPg(pool)
 
@@ -671,9 +718,11 @@ func TestEndToEndChampionshipCreationWithInvalidData(t *testing.T) {
 	require.NoError(t, err)
 
 	// Inicializar serviços
-	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo)
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
-	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, statisticsService)
+	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo, matchRepo, repository.NewCupStatisticsRepositoryPg(pool), repository.NewStandingsSnapshotRepositoryPg(pool))
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
+	playerStatisticsService := service.NewPlayerStatisticsService(repository.NewPlayerStatisticsRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo, repository.NewLineupRepositoryPg(pool), repository.NewPlayerRepositoryPg(pool))
+	suspensionService := service.NewSuspensionService(repository.NewSuspensionRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo)
+	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, matchEventRepo, statisticsService, playerStatisticsService, suspensionService)
 
 	// Iniciar consumidor
 	rabbitMQConsumer, err := messaging.NewRabbitMQConsumer(rabbitConn, "championship_created_test", matchService)
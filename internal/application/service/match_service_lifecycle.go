@@ -0,0 +1,74 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// finishChampionshipIfCompleted encerra o campeonato quando todas as partidas têm desfecho e
+// nenhuma outra partida ainda será criada (próxima rodada do suíço, fase sorteada ou final de
+// desempate, que são geradas antes desta verificação).
+func (s *matchService) finishChampionshipIfCompleted(ctx context.Context, championship *entity.Championship) (err error) {
+	tx, err := s.matchRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	return s.finishChampionshipIfCompletedWithTx(ctx, tx, championship)
+}
+
+// finishChampionshipIfCompletedWithTx faz a mesma verificação dentro de uma transação já aberta,
+// considerando as partidas gravadas nela.
+func (s *matchService) finishChampionshipIfCompletedWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error {
+	matches, err := s.matchRepo.GetByChampionshipIDWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+	if !isChampionshipCompleted(championship, matches) {
+		return nil
+	}
+
+	championship.Status = entity.ChampionshipStatusFinished
+	return s.championshipRepo.UpdateWithTx(ctx, tx, championship)
+}
+
+// isChampionshipCompleted indica se as partidas existentes encerram o campeonato.
+func isChampionshipCompleted(championship *entity.Championship, matches []*entity.Match) bool {
+	if len(matches) == 0 {
+		return false
+	}
+
+	teams := make(map[uuid.UUID]bool)
+	lastRound := 0
+	for _, match := range matches {
+		if !match.IsCompleted() {
+			return false
+		}
+		if match.HomeTeamID != nil {
+			teams[*match.HomeTeamID] = true
+		}
+		if match.AwayTeamID != nil {
+			teams[*match.AwayTeamID] = true
+		}
+		if match.Round > lastRound {
+			lastRound = match.Round
+		}
+	}
+
+	// No sistema suíço as rodadas são criadas uma a uma
+	if championship.Type == entity.ChampionshipTypeSwiss {
+		return lastRound >= championship.NumSwissRounds(len(teams))
+	}
+
+	return true
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// drawRand devolve o gerador usado no sorteio de uma fase. Ele é derivado da semente
+// registrada no campeonato, de modo que qualquer sorteio pode ser reproduzido.
+func drawRand(championship *entity.Championship, phase int) *rand.Rand {
+	return rand.New(rand.NewPCG(uint64(championship.DrawSeed), uint64(phase)))
+}
+
+// newDrawSeed gera uma semente de sorteio diferente de zero.
+func newDrawSeed() int64 {
+	return rand.Int64N(1<<63-1) + 1
+}
+
+// hasDrawnPhases indica se as fases seguintes do mata-mata são sorteadas à medida que a
+// fase anterior termina, em vez de montadas na criação do campeonato.
+func hasDrawnPhases(championship *entity.Championship) bool {
+	return championship.Type == entity.ChampionshipTypeCup &&
+		championship.ProgressionType == entity.ProgressionRandomDraw &&
+		!championship.DoubleElimination
+}
+
+// drawNextPhaseWithTx sorteia e grava, na transação do resultado, os confrontos da fase
+// seguinte quando todas as partidas da fase informada estiverem concluídas.
+func (s *matchService) drawNextPhaseWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship, phase int) error {
+	// A linha do campeonato fica travada até o fim da transação, para que dois resultados
+	// encerrando a fase ao mesmo tempo não sorteiem a fase seguinte duas vezes
+	if _, err := s.championshipRepo.GetByIDWithTx(ctx, tx, championship.ID); err != nil {
+		return err
+	}
+
+	matches, err := s.matchRepo.GetByChampionshipIDWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+	var phaseMatches []*entity.Match
+	for _, match := range matches {
+		switch match.Phase {
+		case phase:
+			phaseMatches = append(phaseMatches, match)
+		case phase + 1:
+			return nil // Fase seguinte já sorteada
+		}
+	}
+
+	drawn, ties, err := drawPhase(championship, phase, phaseMatches)
+	if err != nil || drawn == nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, "SET CONSTRAINTS ALL DEFERRED"); err != nil {
+		return err
+	}
+
+	for _, match := range drawn {
+		if err := s.matchRepo.CreateWithTx(ctx, tx, match); err != nil {
+			return err
+		}
+	}
+	for _, tie := range ties {
+		tie.UpdatedAt = time.Now()
+		if err := s.matchRepo.UpdateWithTx(ctx, tx, tie); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// drawPhase monta por sorteio os confrontos da fase seguinte a partir das partidas de uma
+// fase encerrada. Cada nova partida é ligada, como pai, aos confrontos de onde vieram seus
+// times, que são devolvidos em ties para serem atualizados. Ao sortear a final, cria também
+// a disputa de terceiro lugar. Devolve nil enquanto a fase não terminou ou se ela era a final,
+// e erro se algum confronto terminou sem vencedor, como uma partida cancelada.
+func drawPhase(championship *entity.Championship, phase int, phaseMatches []*entity.Match) ([]*entity.Match, []*entity.Match, error) {
+	// Cada confronto é representado pelo jogo que é nó da chave (o jogo de ida, quando houver)
+	ties := make([]*entity.Match, 0, len(phaseMatches))
+	winners := make(map[uuid.UUID]uuid.UUID)
+	for _, match := range phaseMatches {
+		if match.Bracket == entity.MatchBracketThirdPlace {
+			continue
+		}
+		if !match.IsCompleted() {
+			return nil, nil, nil // A fase ainda não terminou
+		}
+		switch match.Leg {
+		case 1:
+			ties = append(ties, match)
+		case 2:
+			if match.FirstLegMatchID != nil && match.WinnerTeamID != nil {
+				winners[*match.FirstLegMatchID] = *match.WinnerTeamID
+			}
+		default:
+			ties = append(ties, match)
+			if match.WinnerTeamID != nil {
+				winners[match.ID] = *match.WinnerTeamID
+			}
+		}
+	}
+	if len(ties) < 2 {
+		return nil, nil, nil // A fase era a final
+	}
+	for _, tie := range ties {
+		if _, ok := winners[tie.ID]; !ok {
+			return nil, nil, fmt.Errorf("o confronto %s terminou sem vencedor e a fase %d não pode ser sorteada", tie.ID, phase+1)
+		}
+	}
+
+	// Ordem estável antes do sorteio, para que a mesma semente produza os mesmos confrontos
+	sort.Slice(ties, func(i, j int) bool { return ties[i].ID.String() < ties[j].ID.String() })
+
+	rng := drawRand(championship, phase+1)
+	rng.Shuffle(len(ties), func(i, j int) { ties[i], ties[j] = ties[j], ties[i] })
+
+	var drawn []*entity.Match
+	for i := 0; i+1 < len(ties); i += 2 {
+		homeTeamID := winners[ties[i].ID]
+		awayTeamID := winners[ties[i+1].ID]
+		match := &entity.Match{
+			ID:                uuid.New(),
+			ChampionshipID:    championship.ID,
+			HomeTeamID:        &homeTeamID,
+			AwayTeamID:        &awayTeamID,
+			Status:            entity.MatchStatusScheduled,
+			Phase:             phase + 1,
+			LeftChildMatchID:  &ties[i].ID,
+			RightChildMatchID: &ties[i+1].ID,
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+		}
+		ties[i].ParentMatchID = &match.ID
+		ties[i+1].ParentMatchID = &match.ID
+		drawn = append(drawn, match)
+	}
+
+	if championship.TwoLeggedTies {
+		drawn = addSecondLegs(championship, drawn)
+	}
+
+	if championship.ThirdPlaceMatch && len(ties) == 2 {
+		thirdPlace := buildThirdPlaceMatch(championship, ties)
+		thirdPlace.HomeTeamID = loserOf(ties[0], winners[ties[0].ID])
+		thirdPlace.AwayTeamID = loserOf(ties[1], winners[ties[1].ID])
+		drawn = append(drawn, thirdPlace)
+	}
+
+	return drawn, ties, nil
+}
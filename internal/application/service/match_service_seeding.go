@@ -0,0 +1,69 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// validateSeeds garante que os cabeças de chave são times inscritos, sem repetição, e que
+// o campeonato usa uma chave eliminatória.
+func validateSeeds(championship *entity.Championship, teamIDs []uuid.UUID) error {
+	if len(championship.Seeds) == 0 {
+		return nil
+	}
+	if championship.Type != entity.ChampionshipTypeCup {
+		return errors.New("cabeças de chave só são permitidos em campeonatos do tipo copa")
+	}
+
+	enrolled := make(map[uuid.UUID]bool, len(teamIDs))
+	for _, teamID := range teamIDs {
+		enrolled[teamID] = true
+	}
+	seen := make(map[uuid.UUID]bool, len(championship.Seeds))
+	for _, teamID := range championship.Seeds {
+		if !enrolled[teamID] {
+			return fmt.Errorf("o cabeça de chave %s não está entre os times do campeonato", teamID)
+		}
+		if seen[teamID] {
+			return fmt.Errorf("o time %s aparece mais de uma vez entre os cabeças de chave", teamID)
+		}
+		seen[teamID] = true
+	}
+	return nil
+}
+
+// seededSlots distribui os times nas numSlots posições da primeira fase seguindo a ordem
+// padrão de cabeças de chave (1 x 16, 8 x 9, ...). Os cabeças de chave ocupam as primeiras
+// posições do ranking e os demais times seguem na ordem da lista, ou em ordem sorteada na
+// progressão random_draw. As posições que sobram são byes e, por ficarem no fim do ranking,
+// enfrentam os melhores colocados.
+func seededSlots(championship *entity.Championship, teamIDs []uuid.UUID, numSlots int) []*uuid.UUID {
+	isSeed := make(map[uuid.UUID]bool, len(championship.Seeds))
+	ranking := make([]uuid.UUID, 0, len(teamIDs))
+	for _, teamID := range championship.Seeds {
+		isSeed[teamID] = true
+		ranking = append(ranking, teamID)
+	}
+
+	unseeded := make([]uuid.UUID, 0, len(teamIDs))
+	for _, teamID := range teamIDs {
+		if !isSeed[teamID] {
+			unseeded = append(unseeded, teamID)
+		}
+	}
+	if championship.ProgressionType == entity.ProgressionRandomDraw {
+		drawRand(championship, 1).Shuffle(len(unseeded), func(i, j int) { unseeded[i], unseeded[j] = unseeded[j], unseeded[i] })
+	}
+	ranking = append(ranking, unseeded...)
+
+	slots := make([]*uuid.UUID, numSlots)
+	for i, seed := range bracketSeedOrder(numSlots) {
+		if seed <= len(ranking) {
+			slots[i] = &ranking[seed-1]
+		}
+	}
+	return slots
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MatchAward define o placar atribuído quando uma partida é decidida fora de campo, por W.O. ou
+// abandono. Sem placar informado, vale a vitória por 3 a 0.
+type MatchAward struct {
+	WinnerTeamID uuid.UUID
+	ScoreWinner  int
+	ScoreLoser   int
+}
+
+// StartMatch coloca a partida em andamento, com o placar zerado.
+func (s *matchService) StartMatch(ctx context.Context, matchID uuid.UUID) error {
+	match, err := s.getMatchForTransition(ctx, matchID, entity.MatchStatusInProgress)
+	if err != nil {
+		return err
+	}
+	if match.HomeTeamID == nil || match.AwayTeamID == nil {
+		return fmt.Errorf("a partida com ID %s ainda não tem os dois times definidos", matchID)
+	}
+
+	match.Status = entity.MatchStatusInProgress
+	match.ScoreHome, match.ScoreAway = 0, 0
+	match.UpdatedAt = time.Now()
+
+	return s.matchRepo.Update(ctx, match)
+}
+
+// UpdateLiveScore atualiza o placar parcial de uma partida em andamento. O placar só passa a
+// valer para a classificação e para a chave quando a partida é encerrada.
+func (s *matchService) UpdateLiveScore(ctx context.Context, matchID uuid.UUID, scoreHome, scoreAway int) error {
+	if scoreHome < 0 || scoreAway < 0 {
+		return errors.New("o placar não pode ser negativo")
+	}
+
+	match, err := s.getMatch(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if match.Status != entity.MatchStatusInProgress {
+		return fmt.Errorf("a partida com ID %s não está em andamento (status %s)", matchID, match.Status)
+	}
+
+	events, err := s.matchEventRepo.ListByMatchID(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if scoreFromEvents(match, events).goals > 0 {
+		return fmt.Errorf("a partida com ID %s tem gols registrados; o placar é calculado pelos eventos", matchID)
+	}
+
+	match.ScoreHome, match.ScoreAway = scoreHome, scoreAway
+	match.UpdatedAt = time.Now()
+
+	return s.matchRepo.Update(ctx, match)
+}
+
+// FinishMatch encerra uma partida em andamento com o resultado final.
+func (s *matchService) FinishMatch(ctx context.Context, matchID uuid.UUID, result MatchResultUpdate) error {
+	match, err := s.getMatch(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if match.Status != entity.MatchStatusInProgress {
+		return fmt.Errorf("a partida com ID %s não está em andamento (status %s)", matchID, match.Status)
+	}
+
+	return s.UpdateMatchResult(ctx, matchID, result)
+}
+
+// PostponeMatch adia a partida. A nova data é opcional e pode ser definida depois, adiando de novo.
+func (s *matchService) PostponeMatch(ctx context.Context, matchID uuid.UUID, matchDate *time.Time) error {
+	match, err := s.getMatchForTransition(ctx, matchID, entity.MatchStatusPostponed)
+	if err != nil {
+		return err
+	}
+
+	match.Status = entity.MatchStatusPostponed
+	match.MatchDate = matchDate
+	match.UpdatedAt = time.Now()
+
+	return s.matchRepo.Update(ctx, match)
+}
+
+// AbandonMatch interrompe uma partida em andamento. Sem award ela fica abandonada, com o placar
+// parcial, até ser remarcada ou decidida; com award ela é encerrada com o placar atribuído ao
+// vencedor indicado.
+func (s *matchService) AbandonMatch(ctx context.Context, matchID uuid.UUID, award *MatchAward) error {
+	if award == nil {
+		match, err := s.getMatchForTransition(ctx, matchID, entity.MatchStatusAbandoned)
+		if err != nil {
+			return err
+		}
+		match.Status = entity.MatchStatusAbandoned
+		match.UpdatedAt = time.Now()
+		return s.matchRepo.Update(ctx, match)
+	}
+
+	match, err := s.getMatch(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if match.Status != entity.MatchStatusInProgress && match.Status != entity.MatchStatusAbandoned {
+		return fmt.Errorf("a partida com ID %s não está em andamento nem abandonada (status %s)", matchID, match.Status)
+	}
+
+	result, err := awardResult(match, *award)
+	if err != nil {
+		return err
+	}
+	return s.UpdateMatchResult(ctx, matchID, result)
+}
+
+// awardResult monta o resultado da partida decidida fora de campo, marcado como W.O.
+func awardResult(match *entity.Match, award MatchAward) (MatchResultUpdate, error) {
+	if award.ScoreWinner == 0 && award.ScoreLoser == 0 {
+		award.ScoreWinner = 3
+	}
+	if award.ScoreWinner <= award.ScoreLoser || award.ScoreLoser < 0 {
+		return MatchResultUpdate{}, errors.New("o placar atribuído deve dar a vitória ao time indicado")
+	}
+
+	switch {
+	case match.HomeTeamID != nil && *match.HomeTeamID == award.WinnerTeamID:
+		return MatchResultUpdate{ScoreHome: award.ScoreWinner, ScoreAway: award.ScoreLoser, Walkover: true}, nil
+	case match.AwayTeamID != nil && *match.AwayTeamID == award.WinnerTeamID:
+		return MatchResultUpdate{ScoreHome: award.ScoreLoser, ScoreAway: award.ScoreWinner, Walkover: true}, nil
+	default:
+		return MatchResultUpdate{}, fmt.Errorf("o time com ID %s não disputa a partida com ID %s", award.WinnerTeamID, match.ID)
+	}
+}
+
+func (s *matchService) getMatch(ctx context.Context, matchID uuid.UUID) (*entity.Match, error) {
+	match, err := s.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match == nil {
+		return nil, fmt.Errorf("partida com ID %s não encontrada", matchID)
+	}
+	return match, nil
+}
+
+// getMatchForTransition busca a partida e verifica se ela pode passar para o status informado
+// em um campeonato em andamento.
+func (s *matchService) getMatchForTransition(ctx context.Context, matchID uuid.UUID, status entity.MatchStatus) (*entity.Match, error) {
+	match, err := s.getMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if !match.CanTransitionTo(status) {
+		return nil, fmt.Errorf("a partida com ID %s não pode passar de %s para %s", matchID, match.Status, status)
+	}
+
+	championship, err := s.championshipRepo.GetByID(ctx, match.ChampionshipID)
+	if err != nil {
+		return nil, err
+	}
+	if championship == nil {
+		return nil, fmt.Errorf("campeonato com ID %s não encontrado", match.ChampionshipID)
+	}
+	if championship.Status != entity.ChampionshipStatusInProgress {
+		return nil, fmt.Errorf("o campeonato com ID %s não está em andamento (status %s)", championship.ID, championship.Status)
+	}
+
+	return match, nil
+}
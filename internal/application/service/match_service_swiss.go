@@ -0,0 +1,250 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// swissPairing representa um confronto do sistema suíço; AwayTeamID nil indica folga.
+type swissPairing struct {
+	HomeTeamID uuid.UUID
+	AwayTeamID *uuid.UUID
+}
+
+// generateSwissFirstRound gera a primeira rodada colocando a metade de cima da lista contra
+// a metade de baixo (1º x n/2+1º, 2º x n/2+2º, ...).
+func (s *matchService) generateSwissFirstRound(ctx context.Context, championship *entity.Championship, teamIDs []uuid.UUID) ([]*entity.Match, error) {
+	half := (len(teamIDs) + 1) / 2
+	ranking := make([]uuid.UUID, 0, len(teamIDs))
+	for i := 0; i < half; i++ {
+		ranking = append(ranking, teamIDs[i])
+		if i+half < len(teamIDs) {
+			ranking = append(ranking, teamIDs[i+half])
+		}
+	}
+
+	pairings, err := pairSwissRound(ranking, make(map[[2]uuid.UUID]bool), make(map[uuid.UUID]bool), make(map[uuid.UUID]int))
+	if err != nil {
+		return nil, err
+	}
+
+	return buildSwissMatches(championship, pairings, 1), nil
+}
+
+// GenerateNextSwissRound emparelha a próxima rodada do sistema suíço pela classificação atual.
+// O campeonato fica travado durante a geração, para que duas chamadas simultâneas não criem a
+// mesma rodada duas vezes.
+func (s *matchService) GenerateNextSwissRound(ctx context.Context, championshipID uuid.UUID) (err error) {
+	tx, err := s.matchRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	championship, err := s.championshipRepo.GetByIDWithTx(ctx, tx, championshipID)
+	if err != nil {
+		return err
+	}
+	if championship == nil {
+		return fmt.Errorf("campeonato com ID %s não encontrado", championshipID)
+	}
+	if championship.Type != entity.ChampionshipTypeSwiss {
+		return fmt.Errorf("o campeonato %s não é disputado no sistema suíço", championshipID)
+	}
+	if championship.Status != entity.ChampionshipStatusInProgress {
+		return fmt.Errorf("o campeonato com ID %s não está em andamento (status %s)", championshipID, championship.Status)
+	}
+
+	matches, err := s.matchRepo.GetByChampionshipIDWithTx(ctx, tx, championshipID)
+	if err != nil {
+		return err
+	}
+
+	// Confrontos já disputados, folgas e jogos em casa de cada time
+	currentRound := 0
+	played := make(map[[2]uuid.UUID]bool)
+	byes := make(map[uuid.UUID]bool)
+	homeGames := make(map[uuid.UUID]int)
+	for _, match := range matches {
+		if !match.IsCompleted() {
+			return fmt.Errorf("a rodada %d ainda não foi concluída", match.Round)
+		}
+		if match.Round > currentRound {
+			currentRound = match.Round
+		}
+		if match.AwayTeamID == nil {
+			byes[*match.HomeTeamID] = true
+			continue
+		}
+		played[swissPairKey(*match.HomeTeamID, *match.AwayTeamID)] = true
+		homeGames[*match.HomeTeamID]++
+	}
+
+	// A classificação atual define a ordem de emparelhamento
+	standings, err := s.statisticsService.GetStatisticsByChampionship(ctx, championshipID)
+	if err != nil {
+		return err
+	}
+	ranking := make([]uuid.UUID, 0, len(standings))
+	for _, stats := range standings {
+		// Times desclassificados não são mais emparelhados
+		if !stats.Disqualified {
+			ranking = append(ranking, stats.TeamID)
+		}
+	}
+
+	if currentRound >= championship.NumSwissRounds(len(standings)) {
+		return fmt.Errorf("todas as %d rodadas do campeonato já foram disputadas", currentRound)
+	}
+
+	pairings, err := pairSwissRound(ranking, played, byes, homeGames)
+	if err != nil {
+		return err
+	}
+	nextRound := buildSwissMatches(championship, pairings, currentRound+1)
+
+	// Salvar a nova rodada e pontuar a folga na mesma transação
+	for _, match := range nextRound {
+		if err = s.matchRepo.CreateWithTx(ctx, tx, match); err != nil {
+			return err
+		}
+	}
+
+	return s.applySwissByesWithTx(ctx, tx, nextRound)
+}
+
+// applySwissByesWithTx contabiliza as folgas da rodada na classificação.
+func (s *matchService) applySwissByesWithTx(ctx context.Context, tx pgx.Tx, matches []*entity.Match) error {
+	for _, match := range matches {
+		if match.AwayTeamID != nil {
+			continue
+		}
+		if err := s.statisticsService.UpdateStatisticsAfterMatchWithTx(ctx, tx, match); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// swissPairingSteps limita as tentativas da busca com retrocesso de uma rodada. Sem o limite,
+// classificações sem emparelhamento possível levariam tempo exponencial no número de times
+// para serem recusadas.
+const swissPairingSteps = 100000
+
+// pairSwissRound emparelha os times na ordem da classificação, cada um com o adversário mais
+// próximo que ainda não enfrentou. Com número ímpar de times, folga o pior colocado que ainda
+// não folgou. O mando fica com quem jogou menos vezes em casa.
+func pairSwissRound(ranking []uuid.UUID, played map[[2]uuid.UUID]bool, byes map[uuid.UUID]bool, homeGames map[uuid.UUID]int) ([]swissPairing, error) {
+	var pairs [][2]uuid.UUID
+	var byeTeamID *uuid.UUID
+	ok := false
+	steps := swissPairingSteps
+
+	if len(ranking)%2 == 0 {
+		pairs, ok = pairSwissTeams(ranking, played, &steps)
+	} else {
+		for i := len(ranking) - 1; i >= 0 && !ok; i-- {
+			if byes[ranking[i]] {
+				continue
+			}
+			rest := make([]uuid.UUID, 0, len(ranking)-1)
+			rest = append(rest, ranking[:i]...)
+			rest = append(rest, ranking[i+1:]...)
+			if pairs, ok = pairSwissTeams(rest, played, &steps); ok {
+				byeTeamID = &ranking[i]
+			}
+		}
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("não é possível gerar a próxima rodada sem repetir confrontos")
+	}
+
+	pairings := make([]swissPairing, 0, len(pairs)+1)
+	for _, pair := range pairs {
+		home, away := pair[0], pair[1]
+		if homeGames[away] < homeGames[home] {
+			home, away = away, home
+		}
+		pairings = append(pairings, swissPairing{HomeTeamID: home, AwayTeamID: &away})
+	}
+	if byeTeamID != nil {
+		pairings = append(pairings, swissPairing{HomeTeamID: *byeTeamID})
+	}
+
+	return pairings, nil
+}
+
+// pairSwissTeams emparelha o primeiro time com o próximo adversário inédito e tenta resolver o
+// restante recursivamente, voltando atrás quando não houver solução. Cada chamada consome um
+// passo de steps; esgotados os passos, a busca desiste como se não houvesse solução.
+func pairSwissTeams(ranking []uuid.UUID, played map[[2]uuid.UUID]bool, steps *int) ([][2]uuid.UUID, bool) {
+	if len(ranking) == 0 {
+		return nil, true
+	}
+	if *steps <= 0 {
+		return nil, false
+	}
+	*steps--
+
+	first := ranking[0]
+	for i := 1; i < len(ranking); i++ {
+		opponent := ranking[i]
+		if played[swissPairKey(first, opponent)] {
+			continue
+		}
+
+		rest := make([]uuid.UUID, 0, len(ranking)-2)
+		rest = append(rest, ranking[1:i]...)
+		rest = append(rest, ranking[i+1:]...)
+		if pairs, ok := pairSwissTeams(rest, played, steps); ok {
+			return append([][2]uuid.UUID{{first, opponent}}, pairs...), true
+		}
+	}
+
+	return nil, false
+}
+
+// swissPairKey identifica um confronto independentemente do mando de campo.
+func swissPairKey(a, b uuid.UUID) [2]uuid.UUID {
+	if a.String() > b.String() {
+		a, b = b, a
+	}
+	return [2]uuid.UUID{a, b}
+}
+
+func buildSwissMatches(championship *entity.Championship, pairings []swissPairing, round int) []*entity.Match {
+	matches := make([]*entity.Match, 0, len(pairings))
+	for _, pairing := range pairings {
+		homeTeamID := pairing.HomeTeamID
+		match := &entity.Match{
+			ID:             uuid.New(),
+			ChampionshipID: championship.ID,
+			HomeTeamID:     &homeTeamID,
+			AwayTeamID:     pairing.AwayTeamID,
+			Status:         entity.MatchStatusScheduled,
+			Phase:          1,
+			Round:          round,
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		}
+		if pairing.AwayTeamID == nil {
+			// Folga: a partida já nasce concluída com vitória do único time
+			match.Status = entity.MatchStatusBye
+			match.WinnerTeamID = &homeTeamID
+		}
+		matches = append(matches, match)
+	}
+	return matches
+}
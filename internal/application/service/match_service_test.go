@@ -186,3 +186,991 @@ func TestGenerateCupMatches_Fixed_8Teams(t *testing.T) {
 	assert.NotNil(t, finalMatch.LeftChildMatchID)
 	assert.NotNil(t, finalMatch.RightChildMatchID)
 }
+
+func TestGenerateGroupsKnockoutMatches_8Teams_2Groups(t *testing.T) {
+	ctx := context.Background()
+	championship := &entity.Championship{
+		ID:                 uuid.New(),
+		Name:               "Copa do Mundo Teste",
+		Type:               entity.ChampionshipTypeGroupsKnockout,
+		ProgressionType:    entity.ProgressionFixed,
+		NumGroups:          2,
+		QualifiersPerGroup: 2,
+	}
+
+	teamIDs := []uuid.UUID{}
+	for i := 0; i < 8; i++ {
+		teamIDs = append(teamIDs, uuid.New())
+	}
+
+	assert.NoError(t, validateGroupsKnockoutSettings(championship, len(teamIDs)))
+
+	groups := splitIntoGroups(teamIDs, championship.NumGroups)
+	assert.Equal(t, 2, len(groups))
+	assert.Equal(t, []uuid.UUID{teamIDs[0], teamIDs[2], teamIDs[4], teamIDs[6]}, groups[0])
+	assert.Equal(t, []uuid.UUID{teamIDs[1], teamIDs[3], teamIDs[5], teamIDs[7]}, groups[1])
+
+	matchService := &matchService{}
+
+	matches, err := matchService.generateGroupsKnockoutMatches(ctx, championship, groups)
+	assert.NoError(t, err)
+
+	// Fase de grupos: 2 grupos com 6 partidas cada
+	// Mata-mata: 2 semifinais (fase 2) e a final (fase 3)
+	assert.Equal(t, 15, len(matches), "Deve haver 15 partidas no total")
+
+	groupMatches := filterMatchesByPhase(matches, 1)
+	semiFinals := filterMatchesByPhase(matches, 2)
+	finals := filterMatchesByPhase(matches, 3)
+
+	assert.Equal(t, 12, len(groupMatches))
+	assert.Equal(t, 2, len(semiFinals))
+	assert.Equal(t, 1, len(finals))
+
+	for _, match := range groupMatches {
+		assert.NotNil(t, match.GroupNumber, "Partidas da fase de grupos devem ter GroupNumber")
+		assert.Nil(t, match.ParentMatchID)
+	}
+
+	for _, match := range semiFinals {
+		assert.Nil(t, match.GroupNumber)
+		assert.Nil(t, match.HomeTeamID, "O mata-mata só é definido ao fim da fase de grupos")
+		assert.Nil(t, match.AwayTeamID)
+		assert.Equal(t, &finals[0].ID, match.ParentMatchID)
+	}
+}
+
+func TestValidateGroupsKnockoutSettings_Invalid(t *testing.T) {
+	championship := &entity.Championship{
+		Type:               entity.ChampionshipTypeGroupsKnockout,
+		NumGroups:          3,
+		QualifiersPerGroup: 2,
+	}
+
+	// 6 classificados não formam uma chave completa
+	assert.Error(t, validateGroupsKnockoutSettings(championship, 12))
+
+	// Grupos com apenas um time
+	championship.NumGroups = 4
+	assert.Error(t, validateGroupsKnockoutSettings(championship, 4))
+
+	championship.NumGroups = 0
+	assert.Error(t, validateGroupsKnockoutSettings(championship, 8))
+}
+
+func TestBracketSeedOrder(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, bracketSeedOrder(2))
+	assert.Equal(t, []int{1, 4, 2, 3}, bracketSeedOrder(4))
+	assert.Equal(t, []int{1, 8, 4, 5, 2, 7, 3, 6}, bracketSeedOrder(8))
+}
+
+func TestDetermineWinner_Draw(t *testing.T) {
+	homeTeamID := uuid.New()
+	awayTeamID := uuid.New()
+	match := &entity.Match{
+		HomeTeamID: &homeTeamID,
+		AwayTeamID: &awayTeamID,
+		ScoreHome:  1,
+		ScoreAway:  1,
+	}
+
+	matchService := &matchService{}
+
+	winner, err := matchService.determineWinner(match, true)
+	assert.NoError(t, err)
+	assert.Nil(t, winner, "Empate em pontos corridos não tem vencedor")
+
+	_, err = matchService.determineWinner(match, false)
+	assert.Error(t, err, "Empate no mata-mata exige pênaltis")
+}
+
+func TestGenerateLeagueMatches_DoubleRoundRobin(t *testing.T) {
+	ctx := context.Background()
+	championship := &entity.Championship{
+		ID:              uuid.New(),
+		Name:            "Liga Teste",
+		Type:            entity.ChampionshipTypeLeague,
+		ProgressionType: entity.ProgressionFixed,
+		Legs:            2,
+	}
+
+	teamIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+
+	matchService := &matchService{}
+
+	matches, err := matchService.generateLeagueMatches(ctx, championship, teamIDs)
+	assert.NoError(t, err)
+
+	// Com 4 times em turno e returno: 6 confrontos x 2 = 12 partidas
+	assert.Equal(t, 12, len(matches), "Deve haver 12 partidas no total")
+
+	// Cada time deve receber cada adversário exatamente uma vez
+	fixtures := make(map[[2]uuid.UUID]int)
+	for _, match := range matches {
+		fixtures[[2]uuid.UUID{*match.HomeTeamID, *match.AwayTeamID}]++
+	}
+	assert.Equal(t, 12, len(fixtures))
+	for _, count := range fixtures {
+		assert.Equal(t, 1, count)
+	}
+}
+
+func TestGenerateLeagueMatches_FourLegs(t *testing.T) {
+	ctx := context.Background()
+	championship := &entity.Championship{
+		ID:              uuid.New(),
+		Name:            "Liga Teste",
+		Type:            entity.ChampionshipTypeLeague,
+		ProgressionType: entity.ProgressionFixed,
+		Legs:            4,
+	}
+
+	teamIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	matchService := &matchService{}
+
+	matches, err := matchService.generateLeagueMatches(ctx, championship, teamIDs)
+	assert.NoError(t, err)
+
+	// Com 3 times em 4 turnos: 3 confrontos x 4 = 12 partidas, 2 com cada mando
+	assert.Equal(t, 12, len(matches))
+
+	fixtures := make(map[[2]uuid.UUID]int)
+	for _, match := range matches {
+		fixtures[[2]uuid.UUID{*match.HomeTeamID, *match.AwayTeamID}]++
+	}
+	assert.Equal(t, 6, len(fixtures))
+	for _, count := range fixtures {
+		assert.Equal(t, 2, count)
+	}
+}
+
+func TestGenerateLeagueMatches_RoundsCircleMethod(t *testing.T) {
+	ctx := context.Background()
+	championship := &entity.Championship{
+		ID:              uuid.New(),
+		Name:            "Liga Teste",
+		Type:            entity.ChampionshipTypeLeague,
+		ProgressionType: entity.ProgressionFixed,
+	}
+
+	teamIDs := []uuid.UUID{}
+	for i := 0; i < 6; i++ {
+		teamIDs = append(teamIDs, uuid.New())
+	}
+
+	matchService := &matchService{}
+
+	matches, err := matchService.generateLeagueMatches(ctx, championship, teamIDs)
+	assert.NoError(t, err)
+
+	// Com 6 times: 5 rodadas de 3 partidas
+	assert.Equal(t, 15, len(matches))
+
+	rounds := make(map[int][]*entity.Match)
+	for _, match := range matches {
+		rounds[match.Round] = append(rounds[match.Round], match)
+	}
+	assert.Equal(t, 5, len(rounds))
+
+	for round := 1; round <= 5; round++ {
+		assert.Equal(t, 3, len(rounds[round]), "Cada rodada deve ter 3 partidas")
+
+		// Cada time joga no máximo uma vez por rodada
+		played := make(map[uuid.UUID]bool)
+		for _, match := range rounds[round] {
+			assert.False(t, played[*match.HomeTeamID])
+			assert.False(t, played[*match.AwayTeamID])
+			played[*match.HomeTeamID] = true
+			played[*match.AwayTeamID] = true
+		}
+	}
+
+	// O mando de campo deve ser equilibrado: 2 ou 3 jogos em casa para cada time
+	homeGames := make(map[uuid.UUID]int)
+	for _, match := range matches {
+		homeGames[*match.HomeTeamID]++
+	}
+	for _, teamID := range teamIDs {
+		assert.GreaterOrEqual(t, homeGames[teamID], 2)
+		assert.LessOrEqual(t, homeGames[teamID], 3)
+	}
+}
+
+func TestGenerateLeagueMatches_OddTeamsHaveRestRound(t *testing.T) {
+	ctx := context.Background()
+	championship := &entity.Championship{
+		ID:              uuid.New(),
+		Name:            "Liga Teste",
+		Type:            entity.ChampionshipTypeLeague,
+		ProgressionType: entity.ProgressionFixed,
+		Legs:            2,
+	}
+
+	teamIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+
+	matchService := &matchService{}
+
+	matches, err := matchService.generateLeagueMatches(ctx, championship, teamIDs)
+	assert.NoError(t, err)
+
+	// Com 5 times: 5 rodadas de 2 partidas por turno, cada time folga uma vez por turno
+	assert.Equal(t, 20, len(matches))
+
+	rounds := make(map[int]int)
+	for _, match := range matches {
+		rounds[match.Round]++
+	}
+	assert.Equal(t, 10, len(rounds))
+	for round := 1; round <= 10; round++ {
+		assert.Equal(t, 2, rounds[round])
+	}
+}
+
+func TestGenerateSwissFirstRound_TopHalfAgainstBottomHalf(t *testing.T) {
+	ctx := context.Background()
+	championship := &entity.Championship{
+		ID:              uuid.New(),
+		Name:            "Suíço Teste",
+		Type:            entity.ChampionshipTypeSwiss,
+		ProgressionType: entity.ProgressionFixed,
+	}
+
+	teamIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+
+	matchService := &matchService{}
+
+	matches, err := matchService.generateSwissFirstRound(ctx, championship, teamIDs)
+	assert.NoError(t, err)
+
+	// Com 5 times: 2 partidas e 1 folga, todas na rodada 1
+	assert.Equal(t, 3, len(matches))
+
+	opponents := make(map[uuid.UUID]uuid.UUID)
+	var bye *entity.Match
+	for _, match := range matches {
+		assert.Equal(t, 1, match.Round)
+		if match.AwayTeamID == nil {
+			bye = match
+			continue
+		}
+		opponents[*match.HomeTeamID] = *match.AwayTeamID
+		opponents[*match.AwayTeamID] = *match.HomeTeamID
+	}
+
+	// 1º x 4º, 2º x 5º e o 3º (último da lista intercalada) folga
+	assert.Equal(t, teamIDs[3], opponents[teamIDs[0]])
+	assert.Equal(t, teamIDs[4], opponents[teamIDs[1]])
+	assert.NotNil(t, bye)
+	assert.Equal(t, teamIDs[2], *bye.HomeTeamID)
+	assert.Equal(t, entity.MatchStatusBye, bye.Status)
+	assert.Equal(t, &teamIDs[2], bye.WinnerTeamID)
+}
+
+func TestPairSwissRound_AvoidsRematchesAndRepeatedByes(t *testing.T) {
+	ranking := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+
+	// 1º e 2º já se enfrentaram; o último colocado já folgou
+	played := map[[2]uuid.UUID]bool{
+		swissPairKey(ranking[0], ranking[1]): true,
+	}
+	byes := map[uuid.UUID]bool{ranking[4]: true}
+	homeGames := map[uuid.UUID]int{ranking[0]: 1}
+
+	pairings, err := pairSwissRound(ranking, played, byes, homeGames)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(pairings))
+
+	var byeTeam *uuid.UUID
+	for _, pairing := range pairings {
+		if pairing.AwayTeamID == nil {
+			byeTeam = &pairing.HomeTeamID
+			continue
+		}
+		assert.False(t, played[swissPairKey(pairing.HomeTeamID, *pairing.AwayTeamID)], "Confrontos não podem se repetir")
+	}
+
+	assert.NotNil(t, byeTeam)
+	assert.Equal(t, ranking[3], *byeTeam, "A folga vai para o pior colocado que ainda não folgou")
+
+	// O 1º colocado já jogou em casa, então joga fora contra o 3º
+	assert.Equal(t, ranking[2], pairings[0].HomeTeamID)
+	assert.Equal(t, ranking[0], *pairings[0].AwayTeamID)
+}
+
+func TestPairSwissRound_NoPairingPossible(t *testing.T) {
+	ranking := []uuid.UUID{uuid.New(), uuid.New()}
+	played := map[[2]uuid.UUID]bool{
+		swissPairKey(ranking[0], ranking[1]): true,
+	}
+
+	_, err := pairSwissRound(ranking, played, map[uuid.UUID]bool{}, map[uuid.UUID]int{})
+	assert.Error(t, err)
+}
+
+func TestPairSwissRound_GivesUpWhenSearchIsTooLong(t *testing.T) {
+	ranking := make([]uuid.UUID, 30)
+	for i := range ranking {
+		ranking[i] = uuid.New()
+	}
+
+	// O último colocado já enfrentou todos: a busca só descobriria isso depois de emparelhar
+	// os demais de todas as formas possíveis
+	played := make(map[[2]uuid.UUID]bool)
+	last := ranking[len(ranking)-1]
+	for _, teamID := range ranking[:len(ranking)-1] {
+		played[swissPairKey(teamID, last)] = true
+	}
+
+	_, err := pairSwissRound(ranking, played, map[uuid.UUID]bool{}, map[uuid.UUID]int{})
+	assert.Error(t, err)
+}
+
+func TestGenerateCupMatches_DoubleElimination_8Teams(t *testing.T) {
+	ctx := context.Background()
+	championship := &entity.Championship{
+		ID:                uuid.New(),
+		Name:              "Copa Dupla Eliminação",
+		Type:              entity.ChampionshipTypeCup,
+		ProgressionType:   entity.ProgressionFixed,
+		DoubleElimination: true,
+	}
+
+	teamIDs := make([]uuid.UUID, 8)
+	for i := range teamIDs {
+		teamIDs[i] = uuid.New()
+	}
+
+	matchService := &matchService{}
+
+	matches, err := matchService.generateCupMatches(ctx, championship, teamIDs)
+	assert.NoError(t, err)
+
+	byBracket := make(map[entity.MatchBracket][]*entity.Match)
+	matchesByID := make(map[uuid.UUID]*entity.Match)
+	for _, match := range matches {
+		byBracket[match.Bracket] = append(byBracket[match.Bracket], match)
+		matchesByID[match.ID] = match
+	}
+
+	// 7 partidas na chave dos vencedores, 6 na dos perdedores e a grande final
+	assert.Equal(t, 7, len(byBracket[entity.MatchBracketWinners]))
+	assert.Equal(t, 6, len(byBracket[entity.MatchBracketLosers]))
+	assert.Equal(t, 1, len(byBracket[entity.MatchBracketGrandFinal]))
+
+	// Toda partida da chave dos vencedores manda o perdedor para a chave dos perdedores,
+	// e cada partida da chave dos perdedores recebe exatamente duas vagas
+	slots := make(map[uuid.UUID]int)
+	for _, match := range byBracket[entity.MatchBracketWinners] {
+		if assert.NotNil(t, match.LoserMatchID) {
+			target := matchesByID[*match.LoserMatchID]
+			assert.Equal(t, entity.MatchBracketLosers, target.Bracket)
+			slots[target.ID]++
+		}
+	}
+	for _, match := range byBracket[entity.MatchBracketLosers] {
+		assert.Nil(t, match.LoserMatchID)
+		if match.LeftChildMatchID != nil {
+			slots[match.ID]++
+		}
+		if match.RightChildMatchID != nil {
+			slots[match.ID]++
+		}
+		assert.Equal(t, 2, slots[match.ID], "partida da fase %d da chave dos perdedores", match.Phase)
+	}
+
+	// A grande final reúne os campeões das duas chaves
+	grandFinal := byBracket[entity.MatchBracketGrandFinal][0]
+	assert.Equal(t, entity.MatchBracketWinners, matchesByID[*grandFinal.LeftChildMatchID].Bracket)
+	assert.Equal(t, entity.MatchBracketLosers, matchesByID[*grandFinal.RightChildMatchID].Bracket)
+	assert.Equal(t, 5, grandFinal.Phase)
+}
+
+func TestValidateDoubleEliminationSettings_Invalid(t *testing.T) {
+	cup := &entity.Championship{Type: entity.ChampionshipTypeCup, DoubleElimination: true}
+	assert.NoError(t, validateDoubleEliminationSettings(cup, 8))
+	assert.Error(t, validateDoubleEliminationSettings(cup, 6))
+	assert.Error(t, validateDoubleEliminationSettings(cup, 2))
+
+	league := &entity.Championship{Type: entity.ChampionshipTypeLeague, DoubleElimination: true}
+	assert.Error(t, validateDoubleEliminationSettings(league, 8))
+
+	resetOnly := &entity.Championship{Type: entity.ChampionshipTypeCup, BracketReset: true}
+	assert.Error(t, validateDoubleEliminationSettings(resetOnly, 8))
+}
+
+func TestGenerateCupMatches_ThirdPlaceMatch(t *testing.T) {
+	ctx := context.Background()
+	championship := &entity.Championship{
+		ID:              uuid.New(),
+		Name:            "Copa Teste",
+		Type:            entity.ChampionshipTypeCup,
+		ProgressionType: entity.ProgressionFixed,
+		ThirdPlaceMatch: true,
+	}
+
+	teamIDs := make([]uuid.UUID, 8)
+	for i := range teamIDs {
+		teamIDs[i] = uuid.New()
+	}
+
+	matchService := &matchService{}
+
+	matches, err := matchService.generateCupMatches(ctx, championship, teamIDs)
+	assert.NoError(t, err)
+
+	// 7 partidas do mata-mata mais a disputa de terceiro lugar
+	assert.Equal(t, 8, len(matches))
+
+	var thirdPlace *entity.Match
+	for _, match := range matches {
+		if match.Bracket == entity.MatchBracketThirdPlace {
+			thirdPlace = match
+		}
+	}
+	if assert.NotNil(t, thirdPlace) {
+		assert.Equal(t, 3, thirdPlace.Phase)
+		assert.Nil(t, thirdPlace.ParentMatchID)
+
+		// Apenas as semifinais alimentam a disputa de terceiro lugar
+		for _, match := range matches {
+			if match.Phase == 2 {
+				assert.Equal(t, thirdPlace.ID, *match.LoserMatchID)
+			} else {
+				assert.Nil(t, match.LoserMatchID)
+			}
+		}
+	}
+}
+
+func TestValidateThirdPlaceSettings_Invalid(t *testing.T) {
+	cup := &entity.Championship{Type: entity.ChampionshipTypeCup, ProgressionType: entity.ProgressionFixed, ThirdPlaceMatch: true}
+	assert.NoError(t, validateThirdPlaceSettings(cup, 4))
+	assert.Error(t, validateThirdPlaceSettings(cup, 3))
+
+	league := &entity.Championship{Type: entity.ChampionshipTypeLeague, ProgressionType: entity.ProgressionFixed, ThirdPlaceMatch: true}
+	assert.Error(t, validateThirdPlaceSettings(league, 8))
+}
+
+func TestGenerateCupMatches_TwoLeggedSelectedPhases(t *testing.T) {
+	ctx := context.Background()
+	championship := &entity.Championship{
+		ID:              uuid.New(),
+		Name:            "Copa Teste",
+		Type:            entity.ChampionshipTypeCup,
+		ProgressionType: entity.ProgressionFixed,
+		TwoLeggedTies:   true,
+		TwoLeggedPhases: []int{1, 2},
+	}
+
+	teamIDs := make([]uuid.UUID, 8)
+	for i := range teamIDs {
+		teamIDs[i] = uuid.New()
+	}
+
+	matchService := &matchService{}
+
+	matches, err := matchService.generateCupMatches(ctx, championship, teamIDs)
+	assert.NoError(t, err)
+
+	// Quartas e semifinais em ida e volta, final em jogo único
+	assert.Equal(t, 8, len(filterMatchesByPhase(matches, 1)))
+	assert.Equal(t, 4, len(filterMatchesByPhase(matches, 2)))
+	assert.Equal(t, 1, len(filterMatchesByPhase(matches, 3)))
+
+	matchesByID := make(map[uuid.UUID]*entity.Match)
+	for _, match := range matches {
+		matchesByID[match.ID] = match
+	}
+	for _, match := range matches {
+		if match.Leg != 2 {
+			continue
+		}
+		firstLeg := matchesByID[*match.FirstLegMatchID]
+		assert.Equal(t, 1, firstLeg.Leg)
+		assert.Equal(t, firstLeg.Phase, match.Phase)
+		assert.Equal(t, firstLeg.HomeTeamID, match.AwayTeamID)
+		assert.Equal(t, firstLeg.AwayTeamID, match.HomeTeamID)
+		assert.Nil(t, match.ParentMatchID)
+	}
+	assert.Equal(t, 0, filterMatchesByPhase(matches, 3)[0].Leg)
+}
+
+func TestDetermineTieWinner(t *testing.T) {
+	teamA := uuid.New()
+	teamB := uuid.New()
+
+	firstLeg := &entity.Match{HomeTeamID: &teamA, AwayTeamID: &teamB, ScoreHome: 2, ScoreAway: 1}
+	secondLeg := &entity.Match{HomeTeamID: &teamB, AwayTeamID: &teamA, ScoreHome: 1, ScoreAway: 0}
+
+	// Agregado 2x2 sem gols fora de casa nem pênaltis
+	_, err := determineTieWinner(firstLeg, secondLeg, false)
+	assert.Error(t, err)
+
+	// Com gols fora de casa, B marcou 1 fora e A nenhum
+	winner, err := determineTieWinner(firstLeg, secondLeg, true)
+	assert.NoError(t, err)
+	assert.Equal(t, teamB, *winner)
+
+	// Prorrogação do jogo de volta conta para o agregado
+	secondLeg.HasExtraTime = true
+	secondLeg.ScoreAwayExtraTime = 1
+	winner, err = determineTieWinner(firstLeg, secondLeg, true)
+	assert.NoError(t, err)
+	assert.Equal(t, teamA, *winner)
+
+	// Empate no agregado decidido nos pênaltis do jogo de volta
+	secondLeg.ScoreAwayExtraTime = 0
+	secondLeg.HasPenalties = true
+	secondLeg.ScoreHomePenalties = 3
+	secondLeg.ScoreAwayPenalties = 4
+	winner, err = determineTieWinner(firstLeg, secondLeg, false)
+	assert.NoError(t, err)
+	assert.Equal(t, teamA, *winner)
+}
+
+func TestGenerateCupMatches_SeededPlacement(t *testing.T) {
+	ctx := context.Background()
+
+	teamIDs := make([]uuid.UUID, 6)
+	for i := range teamIDs {
+		teamIDs[i] = uuid.New()
+	}
+
+	// Os dois últimos da lista são os cabeças de chave 1 e 2
+	championship := &entity.Championship{
+		ID:              uuid.New(),
+		Name:            "Copa Teste",
+		Type:            entity.ChampionshipTypeCup,
+		ProgressionType: entity.ProgressionFixed,
+		Seeds:           []uuid.UUID{teamIDs[5], teamIDs[4]},
+	}
+
+	matchService := &matchService{}
+
+	matches, err := matchService.generateCupMatches(ctx, championship, teamIDs)
+	assert.NoError(t, err)
+
+	// Ranking: [5 4 0 1 2 3]; ordem da chave de 8 posições: 1x8, 4x5, 2x7, 3x6
+	phase1Matches := filterMatchesByPhase(matches, 1)
+	assert.Equal(t, 4, len(phase1Matches))
+
+	assert.Equal(t, teamIDs[5], *phase1Matches[0].HomeTeamID)
+	assert.Nil(t, phase1Matches[0].AwayTeamID)
+	assert.Equal(t, teamIDs[1], *phase1Matches[1].HomeTeamID)
+	assert.Equal(t, teamIDs[2], *phase1Matches[1].AwayTeamID)
+	assert.Equal(t, teamIDs[4], *phase1Matches[2].HomeTeamID)
+	assert.Nil(t, phase1Matches[2].AwayTeamID)
+	assert.Equal(t, teamIDs[0], *phase1Matches[3].HomeTeamID)
+	assert.Equal(t, teamIDs[3], *phase1Matches[3].AwayTeamID)
+}
+
+func TestValidateSeeds_Invalid(t *testing.T) {
+	teamA := uuid.New()
+	teamB := uuid.New()
+	outsider := uuid.New()
+
+	cup := &entity.Championship{Type: entity.ChampionshipTypeCup, Seeds: []uuid.UUID{teamA}}
+	assert.NoError(t, validateSeeds(cup, []uuid.UUID{teamA, teamB}))
+
+	cup.Seeds = []uuid.UUID{outsider}
+	assert.Error(t, validateSeeds(cup, []uuid.UUID{teamA, teamB}))
+
+	cup.Seeds = []uuid.UUID{teamA, teamA}
+	assert.Error(t, validateSeeds(cup, []uuid.UUID{teamA, teamB}))
+
+	league := &entity.Championship{Type: entity.ChampionshipTypeLeague, Seeds: []uuid.UUID{teamA}}
+	assert.Error(t, validateSeeds(league, []uuid.UUID{teamA, teamB}))
+}
+
+func TestValidateTeamIDs(t *testing.T) {
+	teamA, teamB := uuid.New(), uuid.New()
+
+	assert.NoError(t, validateTeamIDs([]uuid.UUID{teamA, teamB}))
+	assert.Error(t, validateTeamIDs([]uuid.UUID{teamA, teamB, teamA}))
+}
+
+func finishedPhaseMatches(championship *entity.Championship, phase, count int) []*entity.Match {
+	matches := make([]*entity.Match, count)
+	for i := range matches {
+		homeTeamID := uuid.New()
+		awayTeamID := uuid.New()
+		matches[i] = &entity.Match{
+			ID:             uuid.New(),
+			ChampionshipID: championship.ID,
+			HomeTeamID:     &homeTeamID,
+			AwayTeamID:     &awayTeamID,
+			WinnerTeamID:   &homeTeamID,
+			Status:         entity.MatchStatusFinished,
+			Phase:          phase,
+		}
+	}
+	return matches
+}
+
+func TestDrawPhase_RandomDrawNextPhase(t *testing.T) {
+	championship := &entity.Championship{
+		ID:              uuid.New(),
+		Type:            entity.ChampionshipTypeCup,
+		ProgressionType: entity.ProgressionRandomDraw,
+		DrawSeed:        42,
+	}
+
+	phaseMatches := finishedPhaseMatches(championship, 1, 4)
+
+	// Enquanto houver partida pendente, nada é sorteado
+	phaseMatches[3].Status = entity.MatchStatusScheduled
+	drawn, _, err := drawPhase(championship, 1, phaseMatches)
+	assert.NoError(t, err)
+	assert.Nil(t, drawn)
+	phaseMatches[3].Status = entity.MatchStatusFinished
+
+	drawn, ties, err := drawPhase(championship, 1, phaseMatches)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(drawn))
+	assert.Equal(t, 4, len(ties))
+
+	winners := make(map[uuid.UUID]bool)
+	for _, match := range phaseMatches {
+		winners[*match.WinnerTeamID] = true
+	}
+	for _, match := range drawn {
+		assert.Equal(t, 2, match.Phase)
+		assert.True(t, winners[*match.HomeTeamID])
+		assert.True(t, winners[*match.AwayTeamID])
+	}
+	for _, tie := range ties {
+		assert.NotNil(t, tie.ParentMatchID)
+	}
+
+	// A mesma semente reproduz o mesmo sorteio
+	again, _, err := drawPhase(championship, 1, phaseMatches)
+	assert.NoError(t, err)
+	for i := range drawn {
+		assert.Equal(t, *drawn[i].HomeTeamID, *again[i].HomeTeamID)
+		assert.Equal(t, *drawn[i].AwayTeamID, *again[i].AwayTeamID)
+	}
+}
+
+func TestDrawPhase_TieWithoutWinner(t *testing.T) {
+	championship := &entity.Championship{
+		ID:              uuid.New(),
+		Type:            entity.ChampionshipTypeCup,
+		ProgressionType: entity.ProgressionRandomDraw,
+		DrawSeed:        42,
+	}
+
+	// Uma partida cancelada encerra o confronto, mas sem vencedor para levar adiante
+	phaseMatches := finishedPhaseMatches(championship, 1, 4)
+	phaseMatches[2].Status = entity.MatchStatusCancelled
+	phaseMatches[2].WinnerTeamID = nil
+
+	drawn, _, err := drawPhase(championship, 1, phaseMatches)
+	assert.Error(t, err)
+	assert.Nil(t, drawn)
+}
+
+func TestDrawPhase_FinalWithThirdPlace(t *testing.T) {
+	championship := &entity.Championship{
+		ID:              uuid.New(),
+		Type:            entity.ChampionshipTypeCup,
+		ProgressionType: entity.ProgressionRandomDraw,
+		ThirdPlaceMatch: true,
+		DrawSeed:        7,
+	}
+
+	semiFinals := finishedPhaseMatches(championship, 2, 2)
+
+	drawn, _, err := drawPhase(championship, 2, semiFinals)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(drawn))
+
+	final, thirdPlace := drawn[0], drawn[1]
+	assert.Equal(t, 3, final.Phase)
+	assert.Equal(t, entity.MatchBracketThirdPlace, thirdPlace.Bracket)
+	assert.ElementsMatch(t,
+		[]uuid.UUID{*semiFinals[0].AwayTeamID, *semiFinals[1].AwayTeamID},
+		[]uuid.UUID{*thirdPlace.HomeTeamID, *thirdPlace.AwayTeamID})
+
+	// Encerrada a final, não há nova fase
+	drawn, _, err = drawPhase(championship, 3, []*entity.Match{final})
+	assert.NoError(t, err)
+	assert.Nil(t, drawn)
+}
+
+func TestIsChampionshipCompleted(t *testing.T) {
+	league := &entity.Championship{Type: entity.ChampionshipTypeLeague}
+	matches := generateRoundRobinMatches(league, []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}, nil)
+
+	assert.False(t, isChampionshipCompleted(league, matches))
+	for _, match := range matches {
+		match.Status = entity.MatchStatusFinished
+	}
+	assert.True(t, isChampionshipCompleted(league, matches))
+
+	// No suíço com 4 times são 2 rodadas; terminada a primeira, ainda falta gerar a segunda
+	swiss := &entity.Championship{Type: entity.ChampionshipTypeSwiss}
+	firstRound := filterMatchesByRound(matches, 1)
+	assert.False(t, isChampionshipCompleted(swiss, firstRound))
+	assert.True(t, isChampionshipCompleted(swiss, append(firstRound, filterMatchesByRound(matches, 2)...)))
+}
+
+func filterMatchesByRound(matches []*entity.Match, round int) []*entity.Match {
+	var filtered []*entity.Match
+	for _, match := range matches {
+		if match.Round == round {
+			filtered = append(filtered, match)
+		}
+	}
+	return filtered
+}
+
+// finishKnockoutMatch encerra a partida com vitória do mandante e leva os times adiante,
+// como UpdateMatchResult faz com a chave gravada.
+func finishKnockoutMatch(match *entity.Match, byID map[uuid.UUID]*entity.Match) {
+	match.Status = entity.MatchStatusFinished
+	match.ScoreHome, match.ScoreAway = 1, 0
+	match.WinnerTeamID = match.HomeTeamID
+
+	if match.ParentMatchID != nil {
+		parent := byID[*match.ParentMatchID]
+		if *parent.LeftChildMatchID == match.ID {
+			parent.HomeTeamID = match.HomeTeamID
+		} else {
+			parent.AwayTeamID = match.HomeTeamID
+		}
+	}
+	if match.LoserMatchID != nil {
+		loserMatch := byID[*match.LoserMatchID]
+		if loserMatch.HomeTeamID == nil {
+			loserMatch.HomeTeamID = match.AwayTeamID
+		} else {
+			loserMatch.AwayTeamID = match.AwayTeamID
+		}
+	}
+}
+
+// playedFourTeamCup monta uma copa de 4 times com disputa de terceiro lugar e semifinais
+// encerradas, devolvendo as partidas, a final e a disputa de terceiro lugar.
+func playedFourTeamCup(t *testing.T) (*entity.Championship, []*entity.Match, map[uuid.UUID]*entity.Match, *entity.Match, *entity.Match) {
+	championship := &entity.Championship{
+		ID:              uuid.New(),
+		Type:            entity.ChampionshipTypeCup,
+		ProgressionType: entity.ProgressionFixed,
+		ThirdPlaceMatch: true,
+	}
+	teamIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+
+	matches, err := (&matchService{}).generateCupMatches(context.Background(), championship, teamIDs)
+	assert.NoError(t, err)
+
+	byID := make(map[uuid.UUID]*entity.Match)
+	var final, thirdPlace *entity.Match
+	for _, match := range matches {
+		byID[match.ID] = match
+		switch {
+		case match.Bracket == entity.MatchBracketThirdPlace:
+			thirdPlace = match
+		case match.Phase == 2:
+			final = match
+		}
+	}
+	for _, semiFinal := range filterMatchesByPhase(matches, 1) {
+		finishKnockoutMatch(semiFinal, byID)
+	}
+	return championship, matches, byID, final, thirdPlace
+}
+
+func TestPlanCorrection_RepropagatesNewWinner(t *testing.T) {
+	championship, matches, _, final, thirdPlace := playedFourTeamCup(t)
+	corrected := filterMatchesByPhase(matches, 1)[0]
+	oldWinner, newWinner := *corrected.HomeTeamID, *corrected.AwayTeamID
+
+	// Final ainda não disputada: o novo vencedor assume a vaga e o antigo vai para o 3º lugar
+	matchService := &matchService{}
+	plan, err := matchService.planCorrection(championship, matches, nil, corrected.ID, MatchResultUpdate{ScoreHome: 0, ScoreAway: 2}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, newWinner, *corrected.WinnerTeamID)
+	assert.Contains(t, []uuid.UUID{*final.HomeTeamID, *final.AwayTeamID}, newWinner)
+	assert.NotContains(t, []uuid.UUID{*final.HomeTeamID, *final.AwayTeamID}, oldWinner)
+	assert.Contains(t, []uuid.UUID{*thirdPlace.HomeTeamID, *thirdPlace.AwayTeamID}, oldWinner)
+	assert.Len(t, plan.updated, 3)
+}
+
+func TestPlanCorrection_PlayedDownstreamMatchNeedsCascade(t *testing.T) {
+	matchService := &matchService{}
+
+	championship, matches, byID, final, _ := playedFourTeamCup(t)
+	finishKnockoutMatch(final, byID)
+	corrected := filterMatchesByPhase(matches, 1)[0]
+	_, err := matchService.planCorrection(championship, matches, nil, corrected.ID, MatchResultUpdate{ScoreHome: 0, ScoreAway: 2}, false)
+	assert.ErrorIs(t, err, ErrConflict)
+
+	championship, matches, byID, final, _ = playedFourTeamCup(t)
+	finishKnockoutMatch(final, byID)
+	corrected = filterMatchesByPhase(matches, 1)[0]
+	newWinner := *corrected.AwayTeamID
+	_, err = matchService.planCorrection(championship, matches, nil, corrected.ID, MatchResultUpdate{ScoreHome: 0, ScoreAway: 2}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.MatchStatusScheduled, final.Status)
+	assert.Nil(t, final.WinnerTeamID)
+	assert.Contains(t, []uuid.UUID{*final.HomeTeamID, *final.AwayTeamID}, newWinner)
+}
+
+func TestPlanCorrection_ReseedKeepsDisqualifiedTeamsOut(t *testing.T) {
+	ctx := context.Background()
+	championship := &entity.Championship{
+		ID:                 uuid.New(),
+		Type:               entity.ChampionshipTypeGroupsKnockout,
+		ProgressionType:    entity.ProgressionFixed,
+		NumGroups:          2,
+		QualifiersPerGroup: 2,
+	}
+	teamIDs := make([]uuid.UUID, 8)
+	for i := range teamIDs {
+		teamIDs[i] = uuid.New()
+	}
+
+	matchService := &matchService{}
+	matches, err := matchService.generateGroupsKnockoutMatches(ctx, championship, splitIntoGroups(teamIDs, championship.NumGroups))
+	assert.NoError(t, err)
+
+	// Fase de grupos encerrada e semifinais já definidas
+	for _, match := range filterMatchesByPhase(matches, 1) {
+		applyResult(match, MatchResultUpdate{ScoreHome: 1})
+	}
+	for _, semiFinal := range filterMatchesByPhase(matches, 2) {
+		semiFinal.HomeTeamID, semiFinal.AwayTeamID = &teamIDs[0], &teamIDs[1]
+	}
+
+	corrected := filterMatchesByPhase(matches, 1)[0]
+	result := MatchResultUpdate{ScoreHome: 0, ScoreAway: 2}
+	applyResult(corrected, result)
+	leader := computeStandings(championship, matches, nil)[0].TeamID
+
+	_, err = matchService.planCorrection(championship, matches, map[uuid.UUID]bool{leader: true}, corrected.ID, result, false)
+	assert.NoError(t, err)
+	for _, semiFinal := range filterMatchesByPhase(matches, 2) {
+		assert.NotEqual(t, leader, *semiFinal.HomeTeamID, "O time desclassificado não se classifica no recálculo")
+		assert.NotEqual(t, leader, *semiFinal.AwayTeamID)
+	}
+}
+
+func TestPlanCorrection_SecondLegWithoutFirstLeg(t *testing.T) {
+	championship := &entity.Championship{ID: uuid.New(), Type: entity.ChampionshipTypeCup, TwoLeggedTies: true}
+	home, away := uuid.New(), uuid.New()
+	secondLeg := finishedMatch(home, away, 1, 0)
+	secondLeg.ID = uuid.New()
+	secondLeg.Leg = 2
+
+	matchService := &matchService{}
+	_, err := matchService.planCorrection(championship, []*entity.Match{secondLeg}, nil, secondLeg.ID, MatchResultUpdate{ScoreHome: 2}, false)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// Jogo de ida que não está entre as partidas do campeonato
+	firstLegID := uuid.New()
+	secondLeg.FirstLegMatchID = &firstLegID
+	_, err = matchService.planCorrection(championship, []*entity.Match{secondLeg}, nil, secondLeg.ID, MatchResultUpdate{ScoreHome: 2}, false)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestPlanCorrection_SameWinnerOnlyChangesScore(t *testing.T) {
+	ctx := context.Background()
+	championship := &entity.Championship{ID: uuid.New(), Type: entity.ChampionshipTypeCup, ProgressionType: entity.ProgressionFixed}
+	teamIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+
+	matchService := &matchService{}
+	matches, err := matchService.generateCupMatches(ctx, championship, teamIDs)
+	assert.NoError(t, err)
+
+	byID := make(map[uuid.UUID]*entity.Match)
+	for _, match := range matches {
+		byID[match.ID] = match
+	}
+	semiFinal := filterMatchesByPhase(matches, 1)[0]
+	finishKnockoutMatch(semiFinal, byID)
+
+	plan, err := matchService.planCorrection(championship, matches, nil, semiFinal.ID, MatchResultUpdate{ScoreHome: 4, ScoreAway: 1}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, semiFinal.ScoreHome)
+	assert.Len(t, plan.updated, 1)
+
+	// Partidas não encerradas não são corrigidas
+	_, err = matchService.planCorrection(championship, matches, nil, filterMatchesByPhase(matches, 1)[1].ID, MatchResultUpdate{ScoreHome: 1}, false)
+	assert.Error(t, err)
+}
+
+func TestCheckCorrectedScore_RejectsScoreThatDisagreesWithEvents(t *testing.T) {
+	home, away := uuid.New(), uuid.New()
+	match := finishedMatch(home, away, 2, 1)
+	events := []*entity.MatchEvent{
+		{TeamID: home, Type: entity.MatchEventGoal, Minute: 10},
+		{TeamID: home, Type: entity.MatchEventGoal, Minute: 30},
+		{TeamID: away, Type: entity.MatchEventGoal, Minute: 80},
+	}
+
+	// A correção para um placar diferente dos gols registrados é recusada
+	assert.ErrorIs(t, checkCorrectedScore(match, MatchResultUpdate{ScoreHome: 0, ScoreAway: 2}, events), ErrInvalid)
+	assert.Equal(t, 2, match.ScoreHome, "a partida não deve ser alterada pela conferência")
+
+	assert.NoError(t, checkCorrectedScore(match, MatchResultUpdate{ScoreHome: 2, ScoreAway: 1}, events))
+	assert.NoError(t, checkCorrectedScore(match, MatchResultUpdate{ScoreHome: 0, ScoreAway: 3, Walkover: true}, events))
+	assert.NoError(t, checkCorrectedScore(match, MatchResultUpdate{ScoreHome: 0, ScoreAway: 2}, nil))
+}
+
+func TestAwardResult(t *testing.T) {
+	home, away := uuid.New(), uuid.New()
+	match := &entity.Match{ID: uuid.New(), HomeTeamID: &home, AwayTeamID: &away}
+
+	result, err := awardResult(match, MatchAward{WinnerTeamID: away})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, result.ScoreHome)
+	assert.Equal(t, 3, result.ScoreAway)
+	assert.True(t, result.Walkover)
+
+	result, err = awardResult(match, MatchAward{WinnerTeamID: home, ScoreWinner: 2, ScoreLoser: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.ScoreHome)
+	assert.Equal(t, 1, result.ScoreAway)
+
+	_, err = awardResult(match, MatchAward{WinnerTeamID: home, ScoreWinner: 1, ScoreLoser: 1})
+	assert.Error(t, err)
+
+	_, err = awardResult(match, MatchAward{WinnerTeamID: uuid.New()})
+	assert.Error(t, err)
+}
+
+func TestNextForfeit(t *testing.T) {
+	championship := &entity.Championship{Type: entity.ChampionshipTypeCup}
+	team, opponent := uuid.New(), uuid.New()
+
+	firstLeg := &entity.Match{ID: uuid.New(), HomeTeamID: &team, AwayTeamID: &opponent, Status: entity.MatchStatusScheduled, Leg: 1}
+	secondLeg := &entity.Match{ID: uuid.New(), HomeTeamID: &opponent, AwayTeamID: &team, Status: entity.MatchStatusScheduled, Leg: 2}
+	other := &entity.Match{ID: uuid.New(), HomeTeamID: &opponent, Status: entity.MatchStatusScheduled}
+
+	// O jogo de volta só é decidido depois do jogo de ida
+	assert.Equal(t, firstLeg, nextForfeit(championship, []*entity.Match{secondLeg, other, firstLeg}, team))
+
+	firstLeg.Status = entity.MatchStatusFinished
+	assert.Equal(t, secondLeg, nextForfeit(championship, []*entity.Match{secondLeg, other, firstLeg}, team))
+
+	secondLeg.Status = entity.MatchStatusFinished
+	assert.Nil(t, nextForfeit(championship, []*entity.Match{secondLeg, other, firstLeg}, team))
+}
+
+func TestPointsStageOpen(t *testing.T) {
+	group := 1
+	groupMatch := &entity.Match{GroupNumber: &group, Status: entity.MatchStatusScheduled}
+	knockoutMatch := &entity.Match{Status: entity.MatchStatusScheduled}
+	matches := []*entity.Match{groupMatch, knockoutMatch}
+
+	championship := &entity.Championship{Type: entity.ChampionshipTypeGroupsKnockout}
+	assert.True(t, pointsStageOpen(championship, matches))
+
+	// Encerrada a fase de grupos, a desclassificação só afeta o mata-mata
+	groupMatch.Status = entity.MatchStatusFinished
+	assert.False(t, pointsStageOpen(championship, matches))
+
+	championship.Type = entity.ChampionshipTypeLeague
+	assert.True(t, pointsStageOpen(championship, matches))
+}
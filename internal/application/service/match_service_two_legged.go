@@ -0,0 +1,126 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// validateTwoLeggedSettings garante que os confrontos de ida e volta só são pedidos em copas
+// de eliminação simples.
+func validateTwoLeggedSettings(championship *entity.Championship) error {
+	if !championship.TwoLeggedTies {
+		if len(championship.TwoLeggedPhases) > 0 || championship.AwayGoals {
+			return errors.New("fases de ida e volta e gols fora de casa exigem confrontos de ida e volta")
+		}
+		return nil
+	}
+	if championship.Type != entity.ChampionshipTypeCup || championship.DoubleElimination {
+		return errors.New("os confrontos de ida e volta só são permitidos em copas de eliminação simples")
+	}
+	return nil
+}
+
+// addSecondLegs transforma em confrontos de ida e volta as partidas das fases configuradas.
+// A partida existente vira o jogo de ida e continua sendo o nó da chave; o jogo de volta
+// inverte o mando e aponta para a ida por FirstLegMatchID. Confrontos com bye na primeira
+// fase continuam em jogo único.
+func addSecondLegs(championship *entity.Championship, matches []*entity.Match) []*entity.Match {
+	result := make([]*entity.Match, 0, len(matches))
+	for _, match := range matches {
+		result = append(result, match)
+		if !championship.IsTwoLegged(match.Phase) {
+			continue
+		}
+		if match.Phase == 1 && (match.HomeTeamID == nil || match.AwayTeamID == nil) {
+			continue
+		}
+
+		match.Leg = 1
+		result = append(result, &entity.Match{
+			ID:              uuid.New(),
+			ChampionshipID:  match.ChampionshipID,
+			HomeTeamID:      match.AwayTeamID,
+			AwayTeamID:      match.HomeTeamID,
+			Status:          entity.MatchStatusScheduled,
+			Phase:           match.Phase,
+			Leg:             2,
+			FirstLegMatchID: &match.ID,
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
+		})
+	}
+	return result
+}
+
+// determineTieWinner decide um confronto de ida e volta pelo placar agregado, depois,
+// se habilitado, pelos gols marcados fora de casa e por fim pelos pênaltis do jogo de volta.
+// A prorrogação do jogo de volta conta para o agregado.
+func determineTieWinner(firstLeg, secondLeg *entity.Match, awayGoals bool) (*uuid.UUID, error) {
+	firstHome, firstAway := matchGoals(firstLeg)
+	secondHome, secondAway := matchGoals(secondLeg)
+
+	// O mandante do jogo de volta é o visitante da ida
+	aggregateHome := secondHome + firstAway
+	aggregateAway := secondAway + firstHome
+	if aggregateHome > aggregateAway {
+		return secondLeg.HomeTeamID, nil
+	} else if aggregateAway > aggregateHome {
+		return secondLeg.AwayTeamID, nil
+	}
+
+	if awayGoals {
+		if firstAway > secondAway {
+			return secondLeg.HomeTeamID, nil
+		} else if secondAway > firstAway {
+			return secondLeg.AwayTeamID, nil
+		}
+	}
+
+	if secondLeg.HasPenalties {
+		if secondLeg.ScoreHomePenalties > secondLeg.ScoreAwayPenalties {
+			return secondLeg.HomeTeamID, nil
+		} else if secondLeg.ScoreAwayPenalties > secondLeg.ScoreHomePenalties {
+			return secondLeg.AwayTeamID, nil
+		}
+		return nil, errors.New("empate nas penalidades não é permitido")
+	}
+
+	return nil, errors.New("confronto terminou empatado no agregado e não há penalidades")
+}
+
+// matchGoals devolve os gols de cada time somando a prorrogação, quando houver.
+func matchGoals(match *entity.Match) (int, int) {
+	home, away := match.ScoreHome, match.ScoreAway
+	if match.HasExtraTime {
+		home += match.ScoreHomeExtraTime
+		away += match.ScoreAwayExtraTime
+	}
+	return home, away
+}
+
+// syncSecondLegWithTx repete no jogo de volta, com o mando invertido, os times definidos no
+// jogo de ida.
+func (s *matchService) syncSecondLegWithTx(ctx context.Context, tx pgx.Tx, firstLeg *entity.Match) error {
+	if firstLeg.Leg != 1 {
+		return nil
+	}
+
+	secondLeg, err := s.matchRepo.GetSecondLegWithTx(ctx, tx, firstLeg.ID)
+	if err != nil {
+		return err
+	}
+	if secondLeg == nil {
+		return nil
+	}
+
+	secondLeg.HomeTeamID = firstLeg.AwayTeamID
+	secondLeg.AwayTeamID = firstLeg.HomeTeamID
+	secondLeg.UpdatedAt = time.Now()
+
+	return s.matchRepo.UpdateWithTx(ctx, tx, secondLeg)
+}
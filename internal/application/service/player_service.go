@@ -0,0 +1,241 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PlayerService interface {
+	CreatePlayer(ctx context.Context, player *entity.Player) error
+	GetPlayerByID(ctx context.Context, playerID uuid.UUID) (*entity.Player, error)
+	UpdatePlayer(ctx context.Context, player *entity.Player) error
+	DeletePlayer(ctx context.Context, playerID uuid.UUID) error
+	ListPlayersByTeam(ctx context.Context, teamID uuid.UUID) ([]*entity.Player, error)
+	RegisterPlayer(ctx context.Context, championshipID, teamID, playerID uuid.UUID) error
+	UnregisterPlayer(ctx context.Context, championshipID, teamID, playerID uuid.UUID) error
+	ListSquad(ctx context.Context, championshipID, teamID uuid.UUID) ([]*entity.Player, error)
+}
+
+type playerService struct {
+	playerRepo             repository.PlayerRepository
+	teamRepo               repository.TeamRepository
+	championshipRepo       repository.ChampionshipRepository
+	championshipTeamRepo   repository.ChampionshipTeamRepository
+	championshipPlayerRepo repository.ChampionshipPlayerRepository
+}
+
+func NewPlayerService(
+	playerRepo repository.PlayerRepository,
+	teamRepo repository.TeamRepository,
+	championshipRepo repository.ChampionshipRepository,
+	championshipTeamRepo repository.ChampionshipTeamRepository,
+	championshipPlayerRepo repository.ChampionshipPlayerRepository,
+) PlayerService {
+	return &playerService{
+		playerRepo:             playerRepo,
+		teamRepo:               teamRepo,
+		championshipRepo:       championshipRepo,
+		championshipTeamRepo:   championshipTeamRepo,
+		championshipPlayerRepo: championshipPlayerRepo,
+	}
+}
+
+func (s *playerService) CreatePlayer(ctx context.Context, player *entity.Player) error {
+	// Players join the roster on the day they are created unless told otherwise
+	if player.JoinedAt.IsZero() {
+		player.JoinedAt = time.Now()
+	}
+
+	if err := player.Validate(); err != nil {
+		return err
+	}
+
+	// Check if the team exists
+	team, err := s.teamRepo.GetByID(ctx, player.TeamID)
+	if err != nil {
+		return err
+	}
+	if team == nil {
+		return fmt.Errorf("team with ID %s not found", player.TeamID)
+	}
+
+	if err := s.ensureShirtNumberAvailable(ctx, player); err != nil {
+		return err
+	}
+
+	// Set IDs and timestamps
+	player.ID = uuid.New()
+	player.CreatedAt = time.Now()
+	player.UpdatedAt = time.Now()
+
+	return s.playerRepo.Create(ctx, player)
+}
+
+func (s *playerService) GetPlayerByID(ctx context.Context, playerID uuid.UUID) (*entity.Player, error) {
+	return s.playerRepo.GetByID(ctx, playerID)
+}
+
+// UpdatePlayer updates the player's data and roster window. Setting LeftAt releases the player
+// from the team; the team itself cannot be changed, a transfer is a new roster entry.
+func (s *playerService) UpdatePlayer(ctx context.Context, player *entity.Player) error {
+	existingPlayer, err := s.playerRepo.GetByID(ctx, player.ID)
+	if err != nil {
+		return err
+	}
+	if existingPlayer == nil {
+		return fmt.Errorf("player with ID %s not found", player.ID)
+	}
+
+	player.TeamID = existingPlayer.TeamID
+	player.CreatedAt = existingPlayer.CreatedAt
+	if player.JoinedAt.IsZero() {
+		player.JoinedAt = existingPlayer.JoinedAt
+	}
+	player.UpdatedAt = time.Now()
+
+	if err := player.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.ensureShirtNumberAvailable(ctx, player); err != nil {
+		return err
+	}
+
+	return s.playerRepo.Update(ctx, player)
+}
+
+func (s *playerService) DeletePlayer(ctx context.Context, playerID uuid.UUID) error {
+	existingPlayer, err := s.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return err
+	}
+	if existingPlayer == nil {
+		return fmt.Errorf("player with ID %s not found", playerID)
+	}
+
+	return s.playerRepo.Delete(ctx, playerID)
+}
+
+func (s *playerService) ListPlayersByTeam(ctx context.Context, teamID uuid.UUID) ([]*entity.Player, error) {
+	team, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if team == nil {
+		return nil, fmt.Errorf("team with ID %s not found", teamID)
+	}
+
+	return s.playerRepo.ListByTeamID(ctx, teamID)
+}
+
+// ensureShirtNumberAvailable checks that no other player of the team wears the same shirt
+// number during an overlapping roster window.
+func (s *playerService) ensureShirtNumberAvailable(ctx context.Context, player *entity.Player) error {
+	roster, err := s.playerRepo.ListByTeamID(ctx, player.TeamID)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range roster {
+		if other.ID != player.ID && other.ShirtNumber == player.ShirtNumber && rosterWindowsOverlap(player, other) {
+			return fmt.Errorf("shirt number %d is already taken by %s", player.ShirtNumber, other.Name)
+		}
+	}
+
+	return nil
+}
+
+// rosterWindowsOverlap reports whether both players were on the roster at the same time.
+func rosterWindowsOverlap(a, b *entity.Player) bool {
+	return (b.LeftAt == nil || a.JoinedAt.Before(*b.LeftAt)) &&
+		(a.LeftAt == nil || b.JoinedAt.Before(*a.LeftAt))
+}
+
+// RegisterPlayer adds a player to the team's squad list for the championship. Squads stay open
+// until the championship ends, but only players currently on the team's roster can be added.
+func (s *playerService) RegisterPlayer(ctx context.Context, championshipID, teamID, playerID uuid.UUID) error {
+	if err := s.ensureSquadsOpen(ctx, championshipID, teamID); err != nil {
+		return err
+	}
+
+	player, err := s.playerRepo.GetByID(ctx, playerID)
+	if err != nil {
+		return err
+	}
+	if player == nil || player.TeamID != teamID {
+		return fmt.Errorf("player with ID %s not found in team %s", playerID, teamID)
+	}
+	if !player.IsRegisteredAt(time.Now()) {
+		return fmt.Errorf("player with ID %s is no longer on the roster of team %s", playerID, teamID)
+	}
+
+	existing, err := s.championshipPlayerRepo.GetByChampionshipAndPlayer(ctx, championshipID, playerID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("player with ID %s is already registered in championship %s", playerID, championshipID)
+	}
+
+	championshipPlayer := &entity.ChampionshipPlayer{
+		ChampionshipID: championshipID,
+		TeamID:         teamID,
+		PlayerID:       playerID,
+		RegisteredAt:   time.Now(),
+	}
+	if err := championshipPlayer.Validate(); err != nil {
+		return err
+	}
+
+	return s.championshipPlayerRepo.Register(ctx, championshipPlayer)
+}
+
+func (s *playerService) UnregisterPlayer(ctx context.Context, championshipID, teamID, playerID uuid.UUID) error {
+	if err := s.ensureSquadsOpen(ctx, championshipID, teamID); err != nil {
+		return err
+	}
+
+	existing, err := s.championshipPlayerRepo.GetByChampionshipAndPlayer(ctx, championshipID, playerID)
+	if err != nil {
+		return err
+	}
+	if existing == nil || existing.TeamID != teamID {
+		return fmt.Errorf("player with ID %s is not registered for team %s in championship %s", playerID, teamID, championshipID)
+	}
+
+	return s.championshipPlayerRepo.Unregister(ctx, championshipID, playerID)
+}
+
+func (s *playerService) ListSquad(ctx context.Context, championshipID, teamID uuid.UUID) ([]*entity.Player, error) {
+	return s.championshipPlayerRepo.ListPlayersByChampionshipAndTeam(ctx, championshipID, teamID)
+}
+
+// ensureSquadsOpen checks that the championship still accepts squad changes and that the team
+// is enrolled in it.
+func (s *playerService) ensureSquadsOpen(ctx context.Context, championshipID, teamID uuid.UUID) error {
+	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
+	if err != nil {
+		return err
+	}
+	if championship == nil {
+		return fmt.Errorf("championship with ID %s not found", championshipID)
+	}
+	if championship.Status == entity.ChampionshipStatusFinished || championship.Status == entity.ChampionshipStatusCancelled {
+		return fmt.Errorf("championship with ID %s is not accepting squad changes (status %s)", championshipID, championship.Status)
+	}
+
+	enrolled, err := s.championshipTeamRepo.GetByChampionshipAndTeam(ctx, championshipID, teamID)
+	if err != nil {
+		return err
+	}
+	if enrolled == nil {
+		return fmt.Errorf("team with ID %s is not enrolled in championship %s", teamID, championshipID)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRosterWindowsOverlap(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2024, time.January, d, 0, 0, 0, 0, time.UTC)
+	}
+	left := day(10)
+
+	former := &entity.Player{JoinedAt: day(1), LeftAt: &left}
+	current := &entity.Player{JoinedAt: day(5)}
+	newcomer := &entity.Player{JoinedAt: day(10)}
+
+	assert.True(t, rosterWindowsOverlap(former, current))
+	assert.True(t, rosterWindowsOverlap(current, newcomer))
+
+	// A camisa fica livre no dia em que o jogador deixa o time
+	assert.False(t, rosterWindowsOverlap(former, newcomer))
+	assert.False(t, rosterWindowsOverlap(newcomer, former))
+}
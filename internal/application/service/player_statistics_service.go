@@ -0,0 +1,313 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type PlayerStatisticsService interface {
+	RebuildPlayerStatistics(ctx context.Context, championshipID uuid.UUID) error
+	RebuildPlayerStatisticsWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error
+	GetPlayerStatistics(ctx context.Context, championshipID uuid.UUID, order entity.PlayerStatisticsOrder) ([]*entity.PlayerStatistics, error)
+}
+
+type playerStatisticsService struct {
+	playerStatisticsRepo repository.PlayerStatisticsRepository
+	championshipRepo     repository.ChampionshipRepository
+	matchRepo            repository.MatchRepository
+	matchEventRepo       repository.MatchEventRepository
+	lineupRepo           repository.LineupRepository
+	playerRepo           repository.PlayerRepository
+}
+
+func NewPlayerStatisticsService(
+	playerStatisticsRepo repository.PlayerStatisticsRepository,
+	championshipRepo repository.ChampionshipRepository,
+	matchRepo repository.MatchRepository,
+	matchEventRepo repository.MatchEventRepository,
+	lineupRepo repository.LineupRepository,
+	playerRepo repository.PlayerRepository,
+) PlayerStatisticsService {
+	return &playerStatisticsService{
+		playerStatisticsRepo: playerStatisticsRepo,
+		championshipRepo:     championshipRepo,
+		matchRepo:            matchRepo,
+		matchEventRepo:       matchEventRepo,
+		lineupRepo:           lineupRepo,
+		playerRepo:           playerRepo,
+	}
+}
+
+// RebuildPlayerStatistics recalcula os números dos jogadores do campeonato a partir das
+// escalações e dos lances das partidas encerradas, em uma única transação.
+func (s *playerStatisticsService) RebuildPlayerStatistics(ctx context.Context, championshipID uuid.UUID) (err error) {
+	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
+	if err != nil {
+		return err
+	}
+	if championship == nil {
+		return fmt.Errorf("campeonato com ID %s não encontrado", championshipID)
+	}
+
+	tx, err := s.playerStatisticsRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	return s.RebuildPlayerStatisticsWithTx(ctx, tx, championship)
+}
+
+// RebuildPlayerStatisticsWithTx recalcula os números dos jogadores dentro de uma transação já
+// aberta, para que acompanhem o resultado que acabou de ser gravado.
+func (s *playerStatisticsService) RebuildPlayerStatisticsWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error {
+	matches, err := s.matchRepo.GetByChampionshipIDWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+	events, err := s.matchEventRepo.ListByChampionshipIDWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+	lineups, err := s.lineupRepo.ListByChampionshipIDWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+	goalkeepers, err := s.goalkeepers(ctx, lineups, events)
+	if err != nil {
+		return err
+	}
+
+	if err := s.playerStatisticsRepo.DeleteByChampionshipWithTx(ctx, tx, championship.ID); err != nil {
+		return err
+	}
+	for _, stats := range computePlayerStatistics(matches, lineups, events, goalkeepers) {
+		stats.ID = uuid.New()
+		stats.ChampionshipID = championship.ID
+		stats.CreatedAt = time.Now()
+		stats.UpdatedAt = time.Now()
+
+		if err := s.playerStatisticsRepo.CreateWithTx(ctx, tx, stats); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// goalkeepers devolve os goleiros entre os jogadores escalados ou que aparecem nos lances.
+func (s *playerStatisticsService) goalkeepers(ctx context.Context, lineups []*entity.Lineup, events []*entity.MatchEvent) (map[uuid.UUID]bool, error) {
+	goalkeepers := make(map[uuid.UUID]bool)
+	seen := make(map[uuid.UUID]bool)
+	for _, lineup := range lineups {
+		for _, player := range lineup.Players {
+			seen[player.PlayerID] = true
+			if player.Position == entity.PlayerPositionGoalkeeper {
+				goalkeepers[player.PlayerID] = true
+			}
+		}
+	}
+	for _, event := range events {
+		for _, playerID := range []*uuid.UUID{event.PlayerID, event.SubstitutePlayerID} {
+			if playerID == nil || seen[*playerID] {
+				continue
+			}
+			seen[*playerID] = true
+
+			player, err := s.playerRepo.GetByID(ctx, *playerID)
+			if err != nil {
+				return nil, err
+			}
+			if player != nil && player.Position == entity.PlayerPositionGoalkeeper {
+				goalkeepers[*playerID] = true
+			}
+		}
+	}
+	return goalkeepers, nil
+}
+
+// GetPlayerStatistics devolve a tabela de jogadores do campeonato ordenada pelo critério
+// informado: artilharia, assistências, cartões, minutos jogados ou jogos sem sofrer gols.
+func (s *playerStatisticsService) GetPlayerStatistics(ctx context.Context, championshipID uuid.UUID, order entity.PlayerStatisticsOrder) ([]*entity.PlayerStatistics, error) {
+	if order == "" {
+		order = entity.PlayerStatisticsByGoals
+	}
+	less, ok := playerStatisticsOrders[order]
+	if !ok {
+		return nil, fmt.Errorf("critério de ordenação inválido: %s", order)
+	}
+
+	statsList, err := s.playerStatisticsRepo.ListByChampionship(ctx, championshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(statsList, func(i, j int) bool {
+		a, b := statsList[i], statsList[j]
+		if cmp := less(a, b); cmp != 0 {
+			return cmp < 0
+		}
+		return a.PlayerName < b.PlayerName
+	})
+	return statsList, nil
+}
+
+// playerStatisticsOrders compara dois jogadores em cada critério: negativo quando a vem antes
+// de b. Nas tabelas de gols e assistências, quem jogou menos minutos fica à frente no empate.
+var playerStatisticsOrders = map[entity.PlayerStatisticsOrder]func(a, b *entity.PlayerStatistics) int{
+	entity.PlayerStatisticsByGoals: func(a, b *entity.PlayerStatistics) int {
+		return compareDesc(a.Goals, b.Goals, a.Assists, b.Assists, b.MinutesPlayed, a.MinutesPlayed)
+	},
+	entity.PlayerStatisticsByAssists: func(a, b *entity.PlayerStatistics) int {
+		return compareDesc(a.Assists, b.Assists, a.Goals, b.Goals, b.MinutesPlayed, a.MinutesPlayed)
+	},
+	entity.PlayerStatisticsByCards: func(a, b *entity.PlayerStatistics) int {
+		return compareDesc(a.RedCards, b.RedCards, a.YellowCards, b.YellowCards)
+	},
+	entity.PlayerStatisticsByMinutes: func(a, b *entity.PlayerStatistics) int {
+		return compareDesc(a.MinutesPlayed, b.MinutesPlayed, a.MatchesPlayed, b.MatchesPlayed)
+	},
+	entity.PlayerStatisticsByCleanSheets: func(a, b *entity.PlayerStatistics) int {
+		return compareDesc(a.CleanSheets, b.CleanSheets, b.MinutesPlayed, a.MinutesPlayed)
+	},
+}
+
+// compareDesc compara pares de valores em ordem decrescente, passando ao par seguinte em caso
+// de empate.
+func compareDesc(pairs ...int) int {
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i] != pairs[i+1] {
+			return pairs[i+1] - pairs[i]
+		}
+	}
+	return 0
+}
+
+// playerAppearance é a participação de um jogador em uma partida, do minuto em que entrou ao
+// minuto em que saiu.
+type playerAppearance struct {
+	teamID      uuid.UUID
+	from, until int
+}
+
+// computePlayerStatistics soma os números dos jogadores nas partidas encerradas em campo.
+// Jogam a partida os titulares escalados e os jogadores que aparecem nos lances: quem entra por
+// substituição joga a partir do minuto da troca e quem sai ou é expulso joga até o minuto do
+// lance. Reservas que não entraram não contam. Lances sem o ID do jogador não entram na conta.
+func computePlayerStatistics(matches []*entity.Match, lineups []*entity.Lineup, events []*entity.MatchEvent, goalkeepers map[uuid.UUID]bool) []*entity.PlayerStatistics {
+	eventsByMatch := make(map[uuid.UUID][]*entity.MatchEvent)
+	for _, event := range events {
+		eventsByMatch[event.MatchID] = append(eventsByMatch[event.MatchID], event)
+	}
+	lineupsByMatch := make(map[uuid.UUID][]*entity.Lineup)
+	for _, lineup := range lineups {
+		lineupsByMatch[lineup.MatchID] = append(lineupsByMatch[lineup.MatchID], lineup)
+	}
+
+	statsByPlayer := make(map[uuid.UUID]*entity.PlayerStatistics)
+	var order []uuid.UUID
+	statsFor := func(playerID, teamID uuid.UUID) *entity.PlayerStatistics {
+		stats, ok := statsByPlayer[playerID]
+		if !ok {
+			stats = &entity.PlayerStatistics{PlayerID: playerID, TeamID: teamID}
+			statsByPlayer[playerID] = stats
+			order = append(order, playerID)
+		}
+		return stats
+	}
+
+	for _, match := range matches {
+		if match.Status != entity.MatchStatusFinished || match.Walkover {
+			continue
+		}
+
+		length := 90
+		if match.HasExtraTime {
+			length = 120
+		}
+		minute := func(m int) int {
+			return min(m, length)
+		}
+
+		appearances := make(map[uuid.UUID]*playerAppearance)
+		var appeared []uuid.UUID
+		enter := func(playerID *uuid.UUID, teamID uuid.UUID, from int) *playerAppearance {
+			appearance, ok := appearances[*playerID]
+			if !ok {
+				appearance = &playerAppearance{teamID: teamID, from: from, until: length}
+				appearances[*playerID] = appearance
+				appeared = append(appeared, *playerID)
+			}
+			return appearance
+		}
+
+		for _, lineup := range lineupsByMatch[match.ID] {
+			for _, player := range lineup.Starters() {
+				enter(&player.PlayerID, lineup.TeamID, 0)
+			}
+		}
+		for _, event := range eventsByMatch[match.ID] {
+			if event.PlayerID == nil {
+				continue
+			}
+			appearance := enter(event.PlayerID, event.TeamID, 0)
+			stats := statsFor(*event.PlayerID, event.TeamID)
+
+			switch event.Type {
+			case entity.MatchEventGoal:
+				stats.Goals++
+				if event.AssistPlayerID != nil {
+					enter(event.AssistPlayerID, event.TeamID, 0)
+					statsFor(*event.AssistPlayerID, event.TeamID).Assists++
+				}
+			case entity.MatchEventYellowCard:
+				stats.YellowCards++
+			case entity.MatchEventRedCard:
+				stats.RedCards++
+				appearance.until = minute(event.Minute)
+			case entity.MatchEventSubstitution:
+				appearance.until = minute(event.Minute)
+				if event.SubstitutePlayerID != nil {
+					enter(event.SubstitutePlayerID, event.TeamID, minute(event.Minute))
+				}
+			}
+		}
+
+		for _, playerID := range appeared {
+			appearance := appearances[playerID]
+			stats := statsFor(playerID, appearance.teamID)
+			stats.MatchesPlayed++
+			stats.MinutesPlayed += max(0, appearance.until-appearance.from)
+			if goalkeepers[playerID] && goalsConceded(match, appearance.teamID) == 0 {
+				stats.CleanSheets++
+			}
+		}
+	}
+
+	statsList := make([]*entity.PlayerStatistics, 0, len(order))
+	for _, playerID := range order {
+		statsList = append(statsList, statsByPlayer[playerID])
+	}
+	return statsList
+}
+
+// goalsConceded devolve os gols sofridos pelo time na partida, com a prorrogação.
+func goalsConceded(match *entity.Match, teamID uuid.UUID) int {
+	if match.HomeTeamID != nil && *match.HomeTeamID == teamID {
+		return match.ScoreAway + match.ScoreAwayExtraTime
+	}
+	return match.ScoreHome + match.ScoreHomeExtraTime
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputePlayerStatistics(t *testing.T) {
+	home, away := uuid.New(), uuid.New()
+	striker, winger, substitute, keeper, defender := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	match := finishedMatch(home, away, 2, 0)
+	match.ID = uuid.New()
+
+	events := []*entity.MatchEvent{
+		{MatchID: match.ID, TeamID: home, Type: entity.MatchEventGoal, Minute: 10, PlayerID: &striker, AssistPlayerID: &winger},
+		{MatchID: match.ID, TeamID: home, Type: entity.MatchEventYellowCard, Minute: 30, PlayerID: &keeper},
+		{MatchID: match.ID, TeamID: away, Type: entity.MatchEventRedCard, Minute: 40, PlayerID: &defender},
+		{MatchID: match.ID, TeamID: home, Type: entity.MatchEventSubstitution, Minute: 60, PlayerID: &striker, SubstitutePlayerID: &substitute},
+		{MatchID: match.ID, TeamID: home, Type: entity.MatchEventGoal, Minute: 80, PlayerID: &substitute},
+		// Lances sem o ID do jogador não entram na conta
+		{MatchID: match.ID, TeamID: away, Type: entity.MatchEventYellowCard, Minute: 85, PlayerName: "Sem cadastro"},
+	}
+
+	// Partidas decididas por W.O. não contam
+	walkover := finishedMatch(home, away, 3, 0)
+	walkover.ID = uuid.New()
+	walkover.Walkover = true
+	events = append(events, &entity.MatchEvent{MatchID: walkover.ID, TeamID: home, Type: entity.MatchEventGoal, PlayerID: &striker})
+
+	statsList := computePlayerStatistics([]*entity.Match{match, walkover}, nil, events, map[uuid.UUID]bool{keeper: true})
+	byPlayer := make(map[uuid.UUID]*entity.PlayerStatistics)
+	for _, stats := range statsList {
+		byPlayer[stats.PlayerID] = stats
+	}
+	require.Len(t, byPlayer, 5)
+
+	assert.Equal(t, 1, byPlayer[striker].Goals)
+	assert.Equal(t, 60, byPlayer[striker].MinutesPlayed)
+	assert.Equal(t, 1, byPlayer[striker].MatchesPlayed)
+
+	assert.Equal(t, 1, byPlayer[winger].Assists)
+	assert.Equal(t, 90, byPlayer[winger].MinutesPlayed)
+
+	assert.Equal(t, 1, byPlayer[substitute].Goals)
+	assert.Equal(t, 30, byPlayer[substitute].MinutesPlayed)
+
+	assert.Equal(t, 1, byPlayer[keeper].YellowCards)
+	assert.Equal(t, 1, byPlayer[keeper].CleanSheets)
+
+	assert.Equal(t, 1, byPlayer[defender].RedCards)
+	assert.Equal(t, 40, byPlayer[defender].MinutesPlayed)
+	assert.Equal(t, away, byPlayer[defender].TeamID)
+}
+
+func TestComputePlayerStatistics_Lineups(t *testing.T) {
+	home, away := uuid.New(), uuid.New()
+	keeper, striker, benched, substitute := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	match := finishedMatch(home, away, 0, 0)
+	match.ID = uuid.New()
+
+	lineups := []*entity.Lineup{{
+		MatchID: match.ID,
+		TeamID:  home,
+		Players: []*entity.LineupPlayer{
+			{PlayerID: keeper, Position: entity.PlayerPositionGoalkeeper, Starter: true},
+			{PlayerID: striker, Position: entity.PlayerPositionForward, Starter: true},
+			{PlayerID: benched, Position: entity.PlayerPositionForward},
+			{PlayerID: substitute, Position: entity.PlayerPositionMidfielder},
+		},
+	}}
+	events := []*entity.MatchEvent{
+		{MatchID: match.ID, TeamID: home, Type: entity.MatchEventSubstitution, Minute: 75, PlayerID: &striker, SubstitutePlayerID: &substitute},
+	}
+
+	statsList := computePlayerStatistics([]*entity.Match{match}, lineups, events, map[uuid.UUID]bool{keeper: true})
+	byPlayer := make(map[uuid.UUID]*entity.PlayerStatistics)
+	for _, stats := range statsList {
+		byPlayer[stats.PlayerID] = stats
+	}
+	require.Len(t, byPlayer, 3, "Reservas que não entraram não contam")
+
+	// Titulares jogam desde o início, mesmo sem aparecer nos lances
+	assert.Equal(t, 90, byPlayer[keeper].MinutesPlayed)
+	assert.Equal(t, 1, byPlayer[keeper].CleanSheets)
+	assert.Equal(t, 75, byPlayer[striker].MinutesPlayed)
+	assert.Equal(t, 15, byPlayer[substitute].MinutesPlayed)
+}
+
+func TestPlayerStatisticsOrders(t *testing.T) {
+	a := &entity.PlayerStatistics{PlayerName: "A", Goals: 5, Assists: 1, MinutesPlayed: 900, YellowCards: 3}
+	b := &entity.PlayerStatistics{PlayerName: "B", Goals: 5, Assists: 1, MinutesPlayed: 700, RedCards: 1}
+	c := &entity.PlayerStatistics{PlayerName: "C", Goals: 2, Assists: 4, MinutesPlayed: 1000}
+
+	// Empatados em gols e assistências, fica à frente quem jogou menos
+	byGoals := playerStatisticsOrders[entity.PlayerStatisticsByGoals]
+	assert.Negative(t, byGoals(b, a))
+	assert.Negative(t, byGoals(a, c))
+
+	byAssists := playerStatisticsOrders[entity.PlayerStatisticsByAssists]
+	assert.Negative(t, byAssists(c, a))
+
+	byCards := playerStatisticsOrders[entity.PlayerStatisticsByCards]
+	assert.Negative(t, byCards(b, a))
+
+	byMinutes := playerStatisticsOrders[entity.PlayerStatisticsByMinutes]
+	assert.Negative(t, byMinutes(c, a))
+}
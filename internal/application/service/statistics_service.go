@@ -13,29 +13,57 @@ import (
 
 type StatisticsService interface {
 	GenerateInitialStatistics(ctx context.Context, championshipID uuid.UUID, teamIDs []uuid.UUID) error
+	GenerateGroupStatisticsWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID, groups [][]uuid.UUID) error
+	GenerateInitialStatisticsWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID, teamIDs []uuid.UUID) error
 	UpdateStatisticsAfterMatch(ctx context.Context, match *entity.Match) error
+	UpdateStatisticsAfterMatchWithTx(ctx context.Context, tx pgx.Tx, match *entity.Match) error
 	GetStatisticsByChampionship(ctx context.Context, championshipID uuid.UUID) ([]*entity.Statistics, error)
+	GetStatisticsByGroup(ctx context.Context, championshipID uuid.UUID, groupNumber int) ([]*entity.Statistics, error)
+	GetStatisticsByGroupWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship, groupNumber int) ([]*entity.Statistics, error)
+	ComputeStandings(ctx context.Context, championshipID uuid.UUID) ([]*entity.Statistics, error)
+	RebuildStatistics(ctx context.Context, championshipID uuid.UUID) error
+	RebuildStatisticsWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error
+	DisqualifyTeam(ctx context.Context, championshipID, teamID uuid.UUID) error
+	GetCupStatistics(ctx context.Context, championshipID uuid.UUID) ([]*entity.CupStatistics, error)
+	RebuildCupStatistics(ctx context.Context, championshipID uuid.UUID) error
+	RebuildCupStatisticsWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error
+	GetBracketPath(ctx context.Context, championshipID, teamID uuid.UUID) (*entity.BracketPath, error)
+	RebuildStandingsSnapshots(ctx context.Context, championshipID uuid.UUID) error
+	GetStandingsHistory(ctx context.Context, championshipID uuid.UUID) ([]*entity.StandingsTable, error)
+	GetStandingsAtRound(ctx context.Context, championshipID uuid.UUID, round int) (*entity.StandingsTable, error)
+	GetTeamPositions(ctx context.Context, championshipID, teamID uuid.UUID) ([]*entity.StandingsSnapshot, error)
 }
 
 type statisticsService struct {
-	statisticsRepo   repository.StatisticsRepository
-	championshipRepo repository.ChampionshipRepository
-	teamRepo         repository.TeamRepository
+	statisticsRepo        repository.StatisticsRepository
+	championshipRepo      repository.ChampionshipRepository
+	teamRepo              repository.TeamRepository
+	matchRepo             repository.MatchRepository
+	cupStatisticsRepo     repository.CupStatisticsRepository
+	standingsSnapshotRepo repository.StandingsSnapshotRepository
 }
 
 func NewStatisticsService(
 	statisticsRepo repository.StatisticsRepository,
 	championshipRepo repository.ChampionshipRepository,
 	teamRepo repository.TeamRepository,
+	matchRepo repository.MatchRepository,
+	cupStatisticsRepo repository.CupStatisticsRepository,
+	standingsSnapshotRepo repository.StandingsSnapshotRepository,
 ) StatisticsService {
 	return &statisticsService{
-		statisticsRepo:   statisticsRepo,
-		championshipRepo: championshipRepo,
-		teamRepo:         teamRepo,
+		statisticsRepo:        statisticsRepo,
+		championshipRepo:      championshipRepo,
+		teamRepo:              teamRepo,
+		matchRepo:             matchRepo,
+		cupStatisticsRepo:     cupStatisticsRepo,
+		standingsSnapshotRepo: standingsSnapshotRepo,
 	}
 }
 
-func (s *statisticsService) GenerateInitialStatistics(ctx context.Context, championshipID uuid.UUID, teamIDs []uuid.UUID) error {
+// GenerateInitialStatistics cria as estatísticas zeradas dos times: a classificação nas ligas e
+// os números do mata-mata nas copas. Times que já têm estatísticas são mantidos.
+func (s *statisticsService) GenerateInitialStatistics(ctx context.Context, championshipID uuid.UUID, teamIDs []uuid.UUID) (err error) {
 	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
 	if err != nil {
 		return err
@@ -43,8 +71,12 @@ func (s *statisticsService) GenerateInitialStatistics(ctx context.Context, champ
 	if championship == nil {
 		return fmt.Errorf("campeonato com ID %s não encontrado", championshipID)
 	}
-	if championship.Type != entity.ChampionshipTypeLeague {
-		return fmt.Errorf("estatísticas iniciais só são geradas para campeonatos do tipo liga")
+	switch championship.Type {
+	case entity.ChampionshipTypeLeague:
+	case entity.ChampionshipTypeCup:
+		return s.generateInitialCupStatistics(ctx, championshipID, teamIDs)
+	default:
+		return fmt.Errorf("estatísticas iniciais só são geradas para campeonatos do tipo liga ou copa")
 	}
 
 	for _, teamID := range teamIDs {
@@ -55,24 +87,36 @@ func (s *statisticsService) GenerateInitialStatistics(ctx context.Context, champ
 		if team == nil {
 			return fmt.Errorf("time com ID %s não encontrado", teamID)
 		}
+	}
+
+	tx, err := s.statisticsRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
 
+	return s.GenerateInitialStatisticsWithTx(ctx, tx, championshipID, teamIDs)
+}
+
+// GenerateInitialStatisticsWithTx cria as estatísticas zeradas dos times dentro da transação
+// de geração das partidas. Times que já têm estatísticas são mantidos.
+func (s *statisticsService) GenerateInitialStatisticsWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID, teamIDs []uuid.UUID) error {
+	for _, teamID := range teamIDs {
 		stats := &entity.Statistics{
 			ID:             uuid.New(),
 			ChampionshipID: championshipID,
 			TeamID:         teamID,
-			MatchesPlayed:  0,
-			Wins:           0,
-			Draws:          0,
-			Losses:         0,
-			GoalsFor:       0,
-			GoalsAgainst:   0,
-			GoalDifference: 0,
-			Points:         0,
 			CreatedAt:      time.Now(),
 			UpdatedAt:      time.Now(),
 		}
 
-		if err := s.statisticsRepo.Create(ctx, stats); err != nil {
+		if err := s.statisticsRepo.CreateIfNotExistsWithTx(ctx, tx, stats); err != nil {
 			return err
 		}
 	}
@@ -80,9 +124,51 @@ func (s *statisticsService) GenerateInitialStatistics(ctx context.Context, champ
 	return nil
 }
 
+// GenerateGroupStatisticsWithTx cria as estatísticas zeradas de cada time na fase de grupos,
+// dentro da transação de geração das partidas. groups[i] contém os times do grupo i+1.
+func (s *statisticsService) GenerateGroupStatisticsWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID, groups [][]uuid.UUID) error {
+	for i, group := range groups {
+		groupNumber := i + 1
+		for _, teamID := range group {
+			stats := &entity.Statistics{
+				ID:             uuid.New(),
+				ChampionshipID: championshipID,
+				TeamID:         teamID,
+				GroupNumber:    &groupNumber,
+				CreatedAt:      time.Now(),
+				UpdatedAt:      time.Now(),
+			}
+
+			if err := s.statisticsRepo.CreateIfNotExistsWithTx(ctx, tx, stats); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (s *statisticsService) UpdateStatisticsAfterMatch(ctx context.Context, match *entity.Match) error {
+	// Iniciar transação
+	tx, err := s.statisticsRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = s.UpdateStatisticsAfterMatchWithTx(ctx, tx, match); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// UpdateStatisticsAfterMatchWithTx aplica o resultado da partida às estatísticas dentro de uma
+// transação já aberta, permitindo gravar partida e classificação de forma atômica. A
+// classificação das rodadas a partir da rodada da partida é regravada na mesma transação.
+func (s *statisticsService) UpdateStatisticsAfterMatchWithTx(ctx context.Context, tx pgx.Tx, match *entity.Match) error {
 	// Verificar se a partida está concluída
-	if match.Status != entity.MatchStatusFinished {
+	if !match.IsCompleted() {
 		return fmt.Errorf("a partida com ID %s não está concluída", match.ID)
 	}
 
@@ -95,47 +181,105 @@ func (s *statisticsService) UpdateStatisticsAfterMatch(ctx context.Context, matc
 		return fmt.Errorf("campeonato com ID %s não encontrado", match.ChampionshipID)
 	}
 
-	// Atualizar estatísticas apenas para campeonatos do tipo liga
-	if championship.Type != entity.ChampionshipTypeLeague {
-		return nil // Não faz nada se não for liga
+	// Atualizar estatísticas apenas para ligas, sistema suíço e partidas da fase de grupos
+	if !hasStandings(championship, match) {
+		return nil // Não faz nada em partidas eliminatórias
 	}
 
-	// Iniciar transação
-	tx, err := s.statisticsRepo.BeginTx(ctx)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		if err != nil {
-			tx.Rollback(ctx)
-		} else {
-			err = tx.Commit(ctx)
+	// Folga no sistema suíço: vitória sem gols para o único time da partida
+	if match.AwayTeamID == nil {
+		if err := s.updateByeStatistics(ctx, tx, championship, *match.HomeTeamID); err != nil {
+			return err
 		}
-	}()
+		return s.rebuildStandingsSnapshotsFromRoundWithTx(ctx, tx, championship, match.Round)
+	}
 
 	// Atualizar estatísticas do time da casa
-	if err := s.updateTeamStatistics(ctx, tx, championship.ID, *match.HomeTeamID, match, true); err != nil {
+	if err := s.updateTeamStatistics(ctx, tx, championship, *match.HomeTeamID, match, true); err != nil {
 		return err
 	}
 
 	// Atualizar estatísticas do time visitante
-	if err := s.updateTeamStatistics(ctx, tx, championship.ID, *match.AwayTeamID, match, false); err != nil {
+	if err := s.updateTeamStatistics(ctx, tx, championship, *match.AwayTeamID, match, false); err != nil {
 		return err
 	}
 
-	return nil
+	return s.rebuildStandingsSnapshotsFromRoundWithTx(ctx, tx, championship, match.Round)
 }
 
-func (s *statisticsService) updateTeamStatistics(ctx context.Context, tx pgx.Tx, championshipID, teamID uuid.UUID, match *entity.Match, isHomeTeam bool) error {
+// hasStandings indica se a partida conta para a classificação por pontos.
+func hasStandings(championship *entity.Championship, match *entity.Match) bool {
+	switch championship.Type {
+	case entity.ChampionshipTypeLeague, entity.ChampionshipTypeSwiss:
+		return true
+	default:
+		return match.GroupNumber != nil
+	}
+}
+
+// teamStatisticsWithTx busca as estatísticas do time no campeonato, criando-as zeradas quando
+// ainda não existem, como nos campeonatos cujas partidas foram geradas sem elas.
+func (s *statisticsService) teamStatisticsWithTx(ctx context.Context, tx pgx.Tx, championshipID, teamID uuid.UUID, groupNumber *int) (*entity.Statistics, error) {
+	initial := &entity.Statistics{
+		ID:             uuid.New(),
+		ChampionshipID: championshipID,
+		TeamID:         teamID,
+		GroupNumber:    groupNumber,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	if err := s.statisticsRepo.CreateIfNotExistsWithTx(ctx, tx, initial); err != nil {
+		return nil, err
+	}
+
 	stats, err := s.statisticsRepo.GetByChampionshipAndTeamWithTx(ctx, tx, championshipID, teamID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if stats == nil {
-		return fmt.Errorf("estatísticas não encontradas para o time %s no campeonato %s", teamID, championshipID)
+		return nil, fmt.Errorf("estatísticas não encontradas para o time %s no campeonato %s", teamID, championshipID)
+	}
+	return stats, nil
+}
+
+func (s *statisticsService) updateByeStatistics(ctx context.Context, tx pgx.Tx, championship *entity.Championship, teamID uuid.UUID) error {
+	stats, err := s.teamStatisticsWithTx(ctx, tx, championship.ID, teamID, nil)
+	if err != nil {
+		return err
 	}
 
-	// Atualizar estatísticas básicas
+	applyBye(stats, championship.Points())
+	stats.UpdatedAt = time.Now()
+
+	return s.statisticsRepo.UpdateWithTx(ctx, tx, stats)
+}
+
+func (s *statisticsService) updateTeamStatistics(ctx context.Context, tx pgx.Tx, championship *entity.Championship, teamID uuid.UUID, match *entity.Match, isHomeTeam bool) error {
+	stats, err := s.teamStatisticsWithTx(ctx, tx, championship.ID, teamID, match.GroupNumber)
+	if err != nil {
+		return err
+	}
+
+	applyMatchResult(stats, match, isHomeTeam, championship.Points())
+	stats.UpdatedAt = time.Now()
+
+	// Atualizar no banco de dados
+	if err := s.statisticsRepo.UpdateWithTx(ctx, tx, stats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// applyBye soma às estatísticas a folga do sistema suíço, que vale como vitória sem gols.
+func applyBye(stats *entity.Statistics, points entity.PointsSystem) {
+	stats.MatchesPlayed += 1
+	stats.Wins += 1
+	stats.Points += points.Win
+}
+
+// applyMatchResult soma às estatísticas do time o resultado da partida.
+func applyMatchResult(stats *entity.Statistics, match *entity.Match, isHomeTeam bool, points entity.PointsSystem) {
 	stats.MatchesPlayed += 1
 
 	var goalsFor, goalsAgainst int
@@ -154,22 +298,43 @@ func (s *statisticsService) updateTeamStatistics(ctx context.Context, tx pgx.Tx,
 	// Determinar resultado
 	if goalsFor > goalsAgainst {
 		stats.Wins += 1
-		stats.Points += 3
 	} else if goalsFor == goalsAgainst {
 		stats.Draws += 1
-		stats.Points += 1
 	} else {
 		stats.Losses += 1
 	}
+	stats.Points += matchPoints(points, match, isHomeTeam)
+}
 
-	stats.UpdatedAt = time.Now()
+// matchPoints devolve os pontos que o time recebe pela partida conforme a pontuação do
+// campeonato. Empates decididos nos pênaltis usam a pontuação própria da disputa.
+func matchPoints(points entity.PointsSystem, match *entity.Match, isHomeTeam bool) int {
+	goalsFor, goalsAgainst := match.ScoreHome, match.ScoreAway
+	penaltiesFor, penaltiesAgainst := match.ScoreHomePenalties, match.ScoreAwayPenalties
+	if !isHomeTeam {
+		goalsFor, goalsAgainst = goalsAgainst, goalsFor
+		penaltiesFor, penaltiesAgainst = penaltiesAgainst, penaltiesFor
+	}
 
-	// Atualizar no banco de dados
-	if err := s.statisticsRepo.UpdateWithTx(ctx, tx, stats); err != nil {
-		return err
+	var result int
+	switch {
+	case goalsFor > goalsAgainst:
+		result = points.Win
+	case goalsFor < goalsAgainst:
+		result = points.Loss
+	case match.HasPenalties && penaltiesFor > penaltiesAgainst:
+		result = points.ShootoutWin
+	case match.HasPenalties && penaltiesFor < penaltiesAgainst:
+		result = points.ShootoutLoss
+	default:
+		result = points.Draw
 	}
 
-	return nil
+	if points.BonusGoals > 0 && goalsFor >= points.BonusGoals {
+		result += points.Bonus
+	}
+
+	return result
 }
 
 func (s *statisticsService) GetStatisticsByChampionship(ctx context.Context, championshipID uuid.UUID) ([]*entity.Statistics, error) {
@@ -177,5 +342,193 @@ func (s *statisticsService) GetStatisticsByChampionship(ctx context.Context, cha
 	if err != nil {
 		return nil, err
 	}
+	return s.rankStandings(ctx, championshipID, statsList)
+}
+
+func (s *statisticsService) GetStatisticsByGroup(ctx context.Context, championshipID uuid.UUID, groupNumber int) ([]*entity.Statistics, error) {
+	statsList, err := s.statisticsRepo.ListByChampionshipAndGroup(ctx, championshipID, groupNumber)
+	if err != nil {
+		return nil, err
+	}
+	return s.rankStandings(ctx, championshipID, statsList)
+}
+
+// GetStatisticsByGroupWithTx devolve a classificação do grupo dentro de uma transação já aberta,
+// incluindo os resultados ainda não confirmados.
+func (s *statisticsService) GetStatisticsByGroupWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship, groupNumber int) ([]*entity.Statistics, error) {
+	statsList, err := s.statisticsRepo.ListByChampionshipWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return nil, err
+	}
+	var groupStats []*entity.Statistics
+	for _, stats := range statsList {
+		if stats.GroupNumber != nil && *stats.GroupNumber == groupNumber {
+			groupStats = append(groupStats, stats)
+		}
+	}
+
+	matches, err := s.matchRepo.GetByChampionshipIDWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	sortStandings(championship, groupStats, matches)
+	return groupStats, nil
+}
+
+// rankStandings ordena a classificação pelos critérios de desempate do campeonato.
+func (s *statisticsService) rankStandings(ctx context.Context, championshipID uuid.UUID, statsList []*entity.Statistics) ([]*entity.Statistics, error) {
+	if len(statsList) < 2 {
+		return statsList, nil
+	}
+
+	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
+	if err != nil {
+		return nil, err
+	}
+	if championship == nil {
+		return nil, fmt.Errorf("campeonato com ID %s não encontrado", championshipID)
+	}
+
+	matches, err := s.matchRepo.GetByChampionshipID(ctx, championshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	sortStandings(championship, statsList, matches)
 	return statsList, nil
 }
+
+// ComputeStandings monta a classificação diretamente das partidas do campeonato, sem usar os
+// números gravados; das estatísticas só vem a marcação dos times desclassificados. Na fase de
+// grupos cada grupo é ordenado separadamente.
+func (s *statisticsService) ComputeStandings(ctx context.Context, championshipID uuid.UUID) ([]*entity.Statistics, error) {
+	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
+	if err != nil {
+		return nil, err
+	}
+	if championship == nil {
+		return nil, fmt.Errorf("campeonato com ID %s não encontrado", championshipID)
+	}
+
+	stats, err := s.statisticsRepo.ListByChampionship(ctx, championshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.matchRepo.GetByChampionshipID(ctx, championshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	return computeStandings(championship, matches, disqualifiedTeams(stats)), nil
+}
+
+// RebuildStatistics recalcula todas as estatísticas do campeonato a partir das partidas
+// concluídas, em uma única transação, incluindo a classificação de cada rodada e os números do
+// mata-mata. Corrige a classificação depois de alterações em resultados já lançados ou de
+// falhas na atualização incremental.
+func (s *statisticsService) RebuildStatistics(ctx context.Context, championshipID uuid.UUID) (err error) {
+	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
+	if err != nil {
+		return err
+	}
+	if championship == nil {
+		return fmt.Errorf("campeonato com ID %s não encontrado", championshipID)
+	}
+
+	tx, err := s.statisticsRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	return s.RebuildStatisticsWithTx(ctx, tx, championship)
+}
+
+// RebuildStatisticsWithTx faz o recálculo de RebuildStatistics dentro da transação, para que a
+// classificação acompanhe alterações de partidas ainda não confirmadas.
+func (s *statisticsService) RebuildStatisticsWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) (err error) {
+	// As estatísticas ficam travadas até o fim, para que nenhuma atualização incremental se
+	// misture ao recálculo
+	existing, err := s.statisticsRepo.ListByChampionshipWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+
+	matches, err := s.matchRepo.GetByChampionshipIDWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+	computed := make(map[uuid.UUID]*entity.Statistics)
+	for _, stats := range computeStandings(championship, matches, disqualifiedTeams(existing)) {
+		computed[stats.TeamID] = stats
+	}
+
+	// Times com estatísticas gravadas recebem os valores recalculados, ou zerados se não
+	// tiverem mais partidas que contem para a classificação
+	for _, stats := range existing {
+		rebuilt := computed[stats.TeamID]
+		delete(computed, stats.TeamID)
+		if rebuilt == nil {
+			rebuilt = &entity.Statistics{GroupNumber: stats.GroupNumber}
+		}
+
+		stats.GroupNumber = rebuilt.GroupNumber
+		stats.MatchesPlayed = rebuilt.MatchesPlayed
+		stats.Wins = rebuilt.Wins
+		stats.Draws = rebuilt.Draws
+		stats.Losses = rebuilt.Losses
+		stats.GoalsFor = rebuilt.GoalsFor
+		stats.GoalsAgainst = rebuilt.GoalsAgainst
+		stats.GoalDifference = rebuilt.GoalDifference
+		stats.Points = rebuilt.Points
+		stats.UpdatedAt = time.Now()
+
+		if err = s.statisticsRepo.UpdateWithTx(ctx, tx, stats); err != nil {
+			return err
+		}
+	}
+
+	// Times que ainda não tinham estatísticas gravadas
+	for _, stats := range computed {
+		stats.ID = uuid.New()
+		stats.CreatedAt = time.Now()
+		stats.UpdatedAt = time.Now()
+
+		if err = s.statisticsRepo.CreateWithTx(ctx, tx, stats); err != nil {
+			return err
+		}
+	}
+
+	if err = s.rebuildStandingsSnapshotsWithTx(ctx, tx, championship); err != nil {
+		return err
+	}
+	if hasKnockout(championship) {
+		err = s.RebuildCupStatisticsWithTx(ctx, tx, championship)
+	}
+	return err
+}
+
+// DisqualifyTeam marca o time como desclassificado, o que o leva para o fim da classificação.
+// Campeonatos sem classificação por pontos não têm estatísticas a marcar.
+func (s *statisticsService) DisqualifyTeam(ctx context.Context, championshipID, teamID uuid.UUID) error {
+	stats, err := s.statisticsRepo.GetByChampionshipAndTeam(ctx, championshipID, teamID)
+	if err != nil {
+		return err
+	}
+	if stats == nil || stats.Disqualified {
+		return nil
+	}
+
+	stats.Disqualified = true
+	stats.UpdatedAt = time.Now()
+
+	return s.statisticsRepo.Update(ctx, stats)
+}
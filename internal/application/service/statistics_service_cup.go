@@ -0,0 +1,392 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// hasKnockout indica se o campeonato tem partidas eliminatórias.
+func hasKnockout(championship *entity.Championship) bool {
+	return championship.Type == entity.ChampionshipTypeCup || championship.Type == entity.ChampionshipTypeGroupsKnockout
+}
+
+// generateInitialCupStatistics cria as estatísticas zeradas do mata-mata para os times da copa
+// que ainda não as têm.
+func (s *statisticsService) generateInitialCupStatistics(ctx context.Context, championshipID uuid.UUID, teamIDs []uuid.UUID) (err error) {
+	for _, teamID := range teamIDs {
+		team, err := s.teamRepo.GetByID(ctx, teamID)
+		if err != nil {
+			return err
+		}
+		if team == nil {
+			return fmt.Errorf("time com ID %s não encontrado", teamID)
+		}
+	}
+
+	tx, err := s.cupStatisticsRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	existing, err := s.cupStatisticsRepo.ListByChampionshipWithTx(ctx, tx, championshipID)
+	if err != nil {
+		return err
+	}
+	registered := make(map[uuid.UUID]bool, len(existing))
+	for _, stats := range existing {
+		registered[stats.TeamID] = true
+	}
+
+	for _, teamID := range teamIDs {
+		if registered[teamID] {
+			continue
+		}
+		registered[teamID] = true
+
+		stats := &entity.CupStatistics{
+			ID:             uuid.New(),
+			ChampionshipID: championshipID,
+			TeamID:         teamID,
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		}
+
+		if err = s.cupStatisticsRepo.CreateWithTx(ctx, tx, stats); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetCupStatistics devolve as estatísticas do mata-mata, dos times que foram mais longe para os
+// que caíram primeiro.
+func (s *statisticsService) GetCupStatistics(ctx context.Context, championshipID uuid.UUID) ([]*entity.CupStatistics, error) {
+	statsList, err := s.cupStatisticsRepo.ListByChampionship(ctx, championshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	sortCupStatistics(statsList)
+	return statsList, nil
+}
+
+// RebuildCupStatistics recalcula as estatísticas do mata-mata a partir das partidas
+// eliminatórias do campeonato. Campeonatos sem mata-mata não têm o que recalcular.
+func (s *statisticsService) RebuildCupStatistics(ctx context.Context, championshipID uuid.UUID) (err error) {
+	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
+	if err != nil {
+		return err
+	}
+	if championship == nil {
+		return fmt.Errorf("campeonato com ID %s não encontrado", championshipID)
+	}
+	if !hasKnockout(championship) {
+		return nil
+	}
+
+	tx, err := s.cupStatisticsRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	return s.RebuildCupStatisticsWithTx(ctx, tx, championship)
+}
+
+// RebuildCupStatisticsWithTx regrava as estatísticas do mata-mata dentro da transação. Times que
+// já tinham estatísticas mantêm o registro, zerado se não tiverem mais partidas eliminatórias.
+func (s *statisticsService) RebuildCupStatisticsWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error {
+	existing, err := s.cupStatisticsRepo.ListByChampionshipWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+
+	matches, err := s.matchRepo.GetByChampionshipIDWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+	computed := make(map[uuid.UUID]*entity.CupStatistics)
+	rebuilt := computeCupStatistics(championship, matches)
+	for _, stats := range rebuilt {
+		computed[stats.TeamID] = stats
+		stats.ID = uuid.New()
+		stats.CreatedAt = time.Now()
+	}
+	for _, stats := range existing {
+		if previous := computed[stats.TeamID]; previous != nil {
+			previous.ID = stats.ID
+			previous.CreatedAt = stats.CreatedAt
+			continue
+		}
+		rebuilt = append(rebuilt, &entity.CupStatistics{
+			ID:             stats.ID,
+			ChampionshipID: championship.ID,
+			TeamID:         stats.TeamID,
+			CreatedAt:      stats.CreatedAt,
+		})
+	}
+
+	if err := s.cupStatisticsRepo.DeleteByChampionshipWithTx(ctx, tx, championship.ID); err != nil {
+		return err
+	}
+	for _, stats := range rebuilt {
+		stats.UpdatedAt = time.Now()
+		if err := s.cupStatisticsRepo.CreateWithTx(ctx, tx, stats); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetBracketPath monta o caminho do time pela chave do mata-mata diretamente das partidas.
+func (s *statisticsService) GetBracketPath(ctx context.Context, championshipID, teamID uuid.UUID) (*entity.BracketPath, error) {
+	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
+	if err != nil {
+		return nil, err
+	}
+	if championship == nil {
+		return nil, fmt.Errorf("campeonato com ID %s não encontrado", championshipID)
+	}
+	if !hasKnockout(championship) {
+		return nil, fmt.Errorf("o campeonato com ID %s não tem mata-mata", championshipID)
+	}
+
+	matches, err := s.matchRepo.GetByChampionshipID(ctx, championshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	path := computeBracketPath(championship, matches, teamID)
+	if len(path.Steps) == 0 {
+		return nil, fmt.Errorf("o time com ID %s não disputa o mata-mata do campeonato com ID %s", teamID, championshipID)
+	}
+	return path, nil
+}
+
+// knockoutMatches devolve as partidas eliminatórias do campeonato na ordem das fases, sem as
+// anuladas pela desclassificação de um dos times.
+func knockoutMatches(championship *entity.Championship, matches []*entity.Match) []*entity.Match {
+	knockout := make([]*entity.Match, 0, len(matches))
+	for _, match := range matches {
+		if hasStandings(championship, match) || match.Status == entity.MatchStatusCancelled {
+			continue
+		}
+		knockout = append(knockout, match)
+	}
+	sortMatchesByRound(knockout)
+	return knockout
+}
+
+// computeCupStatistics calcula as estatísticas do mata-mata de cada time que entrou na chave.
+// A prorrogação e os pênaltis são creditados a quem venceu o confronto; o jogo em si conta
+// como empate quando foi decidido nos pênaltis. Byes levam o time à fase, mas não contam como
+// partida disputada.
+func computeCupStatistics(championship *entity.Championship, matches []*entity.Match) []*entity.CupStatistics {
+	byID := make(map[uuid.UUID]*entity.Match, len(matches))
+	for _, match := range matches {
+		byID[match.ID] = match
+	}
+
+	computed := make(map[uuid.UUID]*entity.CupStatistics)
+	var ordered []*entity.CupStatistics
+	statsFor := func(teamID uuid.UUID) *entity.CupStatistics {
+		stats, ok := computed[teamID]
+		if !ok {
+			stats = &entity.CupStatistics{ChampionshipID: championship.ID, TeamID: teamID}
+			computed[teamID] = stats
+			ordered = append(ordered, stats)
+		}
+		return stats
+	}
+
+	for _, match := range knockoutMatches(championship, matches) {
+		for _, teamID := range []*uuid.UUID{match.HomeTeamID, match.AwayTeamID} {
+			if teamID != nil {
+				stats := statsFor(*teamID)
+				stats.FurthestPhase = max(stats.FurthestPhase, match.Phase)
+			}
+		}
+		if match.Status != entity.MatchStatusFinished || match.HomeTeamID == nil || match.AwayTeamID == nil {
+			continue
+		}
+
+		home, away := statsFor(*match.HomeTeamID), statsFor(*match.AwayTeamID)
+		applyCupMatch(home, match, true)
+		applyCupMatch(away, match, false)
+
+		if match.WinnerTeamID != nil {
+			if loserTeamID := loserOf(match, *match.WinnerTeamID); loserTeamID != nil &&
+				eliminates(championship, byID, match, *loserTeamID) {
+				statsFor(*loserTeamID).Eliminated = true
+			}
+		}
+	}
+
+	sortCupStatistics(ordered)
+	return ordered
+}
+
+// applyCupMatch soma às estatísticas do mata-mata o resultado da partida, do lado do time.
+func applyCupMatch(stats *entity.CupStatistics, match *entity.Match, isHomeTeam bool) {
+	goalsFor := match.ScoreHome + match.ScoreHomeExtraTime
+	goalsAgainst := match.ScoreAway + match.ScoreAwayExtraTime
+	teamID := *match.HomeTeamID
+	if !isHomeTeam {
+		goalsFor, goalsAgainst = goalsAgainst, goalsFor
+		teamID = *match.AwayTeamID
+	}
+
+	stats.MatchesPlayed++
+	stats.GoalsFor += goalsFor
+	stats.GoalsAgainst += goalsAgainst
+	switch {
+	case goalsFor > goalsAgainst:
+		stats.Wins++
+	case goalsFor < goalsAgainst:
+		stats.Losses++
+	default:
+		stats.Draws++
+	}
+
+	if match.WinnerTeamID == nil {
+		return
+	}
+	won := *match.WinnerTeamID == teamID
+	switch {
+	case match.HasPenalties && won:
+		stats.ShootoutWins++
+	case match.HasPenalties:
+		stats.ShootoutLosses++
+	case match.HasExtraTime && won:
+		stats.ExtraTimeWins++
+	case match.HasExtraTime:
+		stats.ExtraTimeLosses++
+	}
+}
+
+// eliminates indica se a derrota no confronto tira o time da competição. O perdedor segue vivo
+// quando é levado à chave dos perdedores ou quando perde a grande final vindo da chave dos
+// vencedores e ainda tem a final de desempate; a disputa de terceiro lugar não o mantém na
+// briga pelo título.
+func eliminates(championship *entity.Championship, byID map[uuid.UUID]*entity.Match, match *entity.Match, loserTeamID uuid.UUID) bool {
+	tie := match
+	if match.Leg == 2 && match.FirstLegMatchID != nil && byID[*match.FirstLegMatchID] != nil {
+		tie = byID[*match.FirstLegMatchID]
+	}
+
+	if tie.Bracket == entity.MatchBracketGrandFinal && championship.BracketReset &&
+		match.HomeTeamID != nil && *match.HomeTeamID == loserTeamID {
+		return false
+	}
+	if tie.LoserMatchID == nil {
+		return true
+	}
+	next := byID[*tie.LoserMatchID]
+	return next == nil || next.Bracket == entity.MatchBracketThirdPlace
+}
+
+// sortCupStatistics ordena as estatísticas do mata-mata pela fase alcançada, deixando à frente
+// os times ainda vivos, e depois por vitórias, saldo e gols marcados.
+func sortCupStatistics(statsList []*entity.CupStatistics) {
+	sort.SliceStable(statsList, func(i, j int) bool {
+		a, b := statsList[i], statsList[j]
+		if a.FurthestPhase != b.FurthestPhase {
+			return a.FurthestPhase > b.FurthestPhase
+		}
+		if a.Eliminated != b.Eliminated {
+			return !a.Eliminated
+		}
+		return compareDesc(
+			a.Wins, b.Wins,
+			a.GoalDifference(), b.GoalDifference(),
+			a.GoalsFor, b.GoalsFor,
+		) < 0
+	})
+}
+
+// computeBracketPath monta as partidas do time no mata-mata, do lado dele, na ordem das fases.
+func computeBracketPath(championship *entity.Championship, matches []*entity.Match, teamID uuid.UUID) *entity.BracketPath {
+	path := &entity.BracketPath{ChampionshipID: championship.ID, TeamID: teamID, Steps: []*entity.BracketStep{}}
+	for _, stats := range computeCupStatistics(championship, matches) {
+		if stats.TeamID == teamID {
+			path.FurthestPhase = stats.FurthestPhase
+			path.Eliminated = stats.Eliminated
+		}
+	}
+
+	for _, match := range knockoutMatches(championship, matches) {
+		if !hasTeam(match, teamID) {
+			continue
+		}
+		path.Steps = append(path.Steps, bracketStep(match, teamID))
+	}
+
+	return path
+}
+
+// bracketStep descreve a partida do ponto de vista do time.
+func bracketStep(match *entity.Match, teamID uuid.UUID) *entity.BracketStep {
+	home := match.HomeTeamID != nil && *match.HomeTeamID == teamID
+	step := &entity.BracketStep{
+		MatchID:          match.ID,
+		Phase:            match.Phase,
+		Bracket:          match.Bracket,
+		Leg:              match.Leg,
+		MatchDate:        match.MatchDate,
+		Status:           match.Status,
+		Home:             home,
+		OpponentID:       match.AwayTeamID,
+		GoalsFor:         match.ScoreHome + match.ScoreHomeExtraTime,
+		GoalsAgainst:     match.ScoreAway + match.ScoreAwayExtraTime,
+		ExtraTime:        match.HasExtraTime,
+		Penalties:        match.HasPenalties,
+		PenaltiesFor:     match.ScoreHomePenalties,
+		PenaltiesAgainst: match.ScoreAwayPenalties,
+		Walkover:         match.Walkover,
+	}
+	if !home {
+		step.OpponentID = match.HomeTeamID
+		step.GoalsFor, step.GoalsAgainst = step.GoalsAgainst, step.GoalsFor
+		step.PenaltiesFor, step.PenaltiesAgainst = step.PenaltiesAgainst, step.PenaltiesFor
+	}
+
+	switch {
+	case match.Status == entity.MatchStatusBye:
+		step.Result = entity.BracketResultBye
+	case match.Status != entity.MatchStatusFinished:
+		step.Result = entity.BracketResultPending
+	case match.WinnerTeamID != nil && *match.WinnerTeamID == teamID:
+		step.Result = entity.BracketResultWon
+	case match.WinnerTeamID != nil:
+		step.Result = entity.BracketResultLost
+	case step.GoalsFor > step.GoalsAgainst:
+		step.Result = entity.BracketResultWon
+	case step.GoalsFor < step.GoalsAgainst:
+		step.Result = entity.BracketResultLost
+	default:
+		step.Result = entity.BracketResultDrawn
+	}
+
+	return step
+}
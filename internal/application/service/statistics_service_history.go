@@ -0,0 +1,187 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// RebuildStandingsSnapshots regrava a classificação de cada rodada do campeonato a partir das
+// partidas concluídas.
+func (s *statisticsService) RebuildStandingsSnapshots(ctx context.Context, championshipID uuid.UUID) (err error) {
+	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
+	if err != nil {
+		return err
+	}
+	if championship == nil {
+		return fmt.Errorf("campeonato com ID %s não encontrado", championshipID)
+	}
+	if championship.Type == entity.ChampionshipTypeCup {
+		return nil
+	}
+
+	tx, err := s.standingsSnapshotRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	return s.rebuildStandingsSnapshotsWithTx(ctx, tx, championship)
+}
+
+func (s *statisticsService) rebuildStandingsSnapshotsWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error {
+	if err := s.standingsSnapshotRepo.DeleteByChampionshipWithTx(ctx, tx, championship.ID); err != nil {
+		return err
+	}
+	return s.writeStandingsSnapshotsWithTx(ctx, tx, championship, 1)
+}
+
+// rebuildStandingsSnapshotsFromRoundWithTx regrava só a classificação das rodadas a partir de
+// fromRound. Uma partida não altera as tabelas das rodadas anteriores à sua, então o resultado
+// de uma partida não precisa refazer o histórico inteiro.
+func (s *statisticsService) rebuildStandingsSnapshotsFromRoundWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship, fromRound int) error {
+	if err := s.standingsSnapshotRepo.DeleteFromRoundWithTx(ctx, tx, championship.ID, fromRound); err != nil {
+		return err
+	}
+	return s.writeStandingsSnapshotsWithTx(ctx, tx, championship, fromRound)
+}
+
+func (s *statisticsService) writeStandingsSnapshotsWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship, fromRound int) error {
+	matches, err := s.matchRepo.GetByChampionshipIDWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+
+	// Os desclassificados ficam no fim de todas as tabelas
+	statsList, err := s.statisticsRepo.ListByChampionshipWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range computeStandingsSnapshotsFromRound(championship, matches, disqualifiedTeams(statsList), fromRound) {
+		snapshot.CreatedAt = time.Now()
+		if err := s.standingsSnapshotRepo.CreateWithTx(ctx, tx, snapshot); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetStandingsHistory devolve a classificação ao fim de cada rodada já iniciada, da primeira à
+// mais recente.
+func (s *statisticsService) GetStandingsHistory(ctx context.Context, championshipID uuid.UUID) ([]*entity.StandingsTable, error) {
+	snapshots, err := s.standingsSnapshotRepo.ListByChampionship(ctx, championshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := []*entity.StandingsTable{}
+	for _, snapshot := range snapshots {
+		if len(tables) == 0 || tables[len(tables)-1].Round != snapshot.Round {
+			tables = append(tables, &entity.StandingsTable{Round: snapshot.Round})
+		}
+		table := tables[len(tables)-1]
+		table.Standings = append(table.Standings, snapshot)
+	}
+	return tables, nil
+}
+
+// GetStandingsAtRound devolve a classificação como estava ao fim da rodada.
+func (s *statisticsService) GetStandingsAtRound(ctx context.Context, championshipID uuid.UUID, round int) (*entity.StandingsTable, error) {
+	snapshots, err := s.standingsSnapshotRepo.ListByChampionshipAndRound(ctx, championshipID, round)
+	if err != nil {
+		return nil, err
+	}
+	if len(snapshots) == 0 {
+		return nil, fmt.Errorf("a classificação da rodada %d ainda não foi registrada", round)
+	}
+
+	return &entity.StandingsTable{Round: round, Standings: snapshots}, nil
+}
+
+// GetTeamPositions devolve a posição do time ao fim de cada rodada.
+func (s *statisticsService) GetTeamPositions(ctx context.Context, championshipID, teamID uuid.UUID) ([]*entity.StandingsSnapshot, error) {
+	return s.standingsSnapshotRepo.ListByChampionshipAndTeam(ctx, championshipID, teamID)
+}
+
+// computeStandingsSnapshots monta a classificação ao fim de cada rodada, da primeira até a última
+// rodada com partida encerrada. Cada tabela conta só as partidas das rodadas até ela, de modo
+// que uma partida adiada entra na rodada a que pertence assim que for disputada.
+func computeStandingsSnapshots(championship *entity.Championship, matches []*entity.Match, disqualified map[uuid.UUID]bool) []*entity.StandingsSnapshot {
+	return computeStandingsSnapshotsFromRound(championship, matches, disqualified, 1)
+}
+
+// computeStandingsSnapshotsFromRound monta, como computeStandingsSnapshots, só as tabelas das
+// rodadas a partir de fromRound.
+func computeStandingsSnapshotsFromRound(championship *entity.Championship, matches []*entity.Match, disqualified map[uuid.UUID]bool, fromRound int) []*entity.StandingsSnapshot {
+	lastRound := 0
+	for _, match := range matches {
+		if match.Status == entity.MatchStatusFinished && hasStandings(championship, match) {
+			lastRound = max(lastRound, match.Round)
+		}
+	}
+
+	var snapshots []*entity.StandingsSnapshot
+	for round := max(fromRound, 1); round <= lastRound; round++ {
+		position := 0
+		var group *int
+		for i, stats := range computeStandings(championship, matchesAsOfRound(matches, round), disqualified) {
+			if i == 0 || !sameGroup(group, stats.GroupNumber) {
+				position = 0
+				group = stats.GroupNumber
+			}
+			position++
+
+			snapshots = append(snapshots, &entity.StandingsSnapshot{
+				ChampionshipID: championship.ID,
+				Round:          round,
+				TeamID:         stats.TeamID,
+				GroupNumber:    stats.GroupNumber,
+				Position:       position,
+				MatchesPlayed:  stats.MatchesPlayed,
+				Wins:           stats.Wins,
+				Draws:          stats.Draws,
+				Losses:         stats.Losses,
+				GoalsFor:       stats.GoalsFor,
+				GoalsAgainst:   stats.GoalsAgainst,
+				GoalDifference: stats.GoalDifference,
+				Points:         stats.Points,
+			})
+		}
+	}
+
+	return snapshots
+}
+
+// matchesAsOfRound devolve as partidas como estavam ao fim da rodada: as das rodadas seguintes
+// aparecem como ainda não disputadas, mantendo os times na tabela.
+func matchesAsOfRound(matches []*entity.Match, round int) []*entity.Match {
+	asOf := make([]*entity.Match, 0, len(matches))
+	for _, match := range matches {
+		if match.Round > round {
+			pending := *match
+			pending.Status = entity.MatchStatusScheduled
+			match = &pending
+		}
+		asOf = append(asOf, match)
+	}
+	return asOf
+}
+
+func sameGroup(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// standingsRanker aplica os critérios de desempate do campeonato. Os critérios de confronto
+// direto e de gols como visitante são calculados a partir das partidas concluídas, já que as
+// estatísticas agregadas não guardam essa informação.
+type standingsRanker struct {
+	championship *entity.Championship
+	matches      []*entity.Match
+	teamIDs      []uuid.UUID
+	lots         map[uuid.UUID]int
+}
+
+// sortStandings ordena a classificação por pontos e, entre os times empatados, aplica em
+// sequência os critérios de desempate do campeonato. Times desclassificados ficam no fim,
+// ordenados entre si pelos mesmos critérios.
+func sortStandings(championship *entity.Championship, standings []*entity.Statistics, matches []*entity.Match) {
+	ranker := &standingsRanker{championship: championship}
+	for _, s := range standings {
+		ranker.teamIDs = append(ranker.teamIDs, s.TeamID)
+	}
+	for _, match := range matches {
+		if match.Status == entity.MatchStatusFinished && match.HomeTeamID != nil && match.AwayTeamID != nil &&
+			hasStandings(championship, match) {
+			ranker.matches = append(ranker.matches, match)
+		}
+	}
+
+	disqualified := func(s *entity.Statistics) int {
+		if s.Disqualified {
+			return 1
+		}
+		return 0
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].Disqualified != standings[j].Disqualified {
+			return !standings[i].Disqualified
+		}
+		return standings[i].Points > standings[j].Points
+	})
+	forEachTie(standings, disqualified, func(part []*entity.Statistics) {
+		forEachTie(part, func(s *entity.Statistics) int { return s.Points }, func(tied []*entity.Statistics) {
+			ranker.breakTies(tied, championship.StandingsTiebreakers())
+		})
+	})
+}
+
+// breakTies ordena um grupo de times empatados pelo primeiro critério e segue para os
+// critérios seguintes com os times que continuarem empatados.
+func (r *standingsRanker) breakTies(tied []*entity.Statistics, criteria []entity.StandingsCriterion) {
+	if len(tied) < 2 || len(criteria) == 0 {
+		return
+	}
+	if criteria[0] == entity.CriterionHeadToHead {
+		r.miniLeague(tied, criteria[1:])
+		return
+	}
+
+	values := r.criterionValues(criteria[0], tied)
+	sortByValues(tied, []map[uuid.UUID]int{values}, func(stillTied []*entity.Statistics) {
+		r.breakTies(stillTied, criteria[1:])
+	})
+}
+
+// miniLeague ordena os empatados por pontos, saldo e gols marcados apenas nas partidas entre
+// eles. Se parte dos times continuar empatada, uma nova mini-classificação é montada só com
+// as partidas entre esses times; quando o confronto direto não separa mais ninguém, seguem os
+// demais critérios.
+func (r *standingsRanker) miniLeague(tied []*entity.Statistics, criteria []entity.StandingsCriterion) {
+	table := r.headToHeadTable(tied)
+	points := make(map[uuid.UUID]int, len(tied))
+	goalDifference := make(map[uuid.UUID]int, len(tied))
+	goalsFor := make(map[uuid.UUID]int, len(tied))
+	for teamID, stats := range table {
+		points[teamID] = stats.Points
+		goalDifference[teamID] = stats.GoalDifference
+		goalsFor[teamID] = stats.GoalsFor
+	}
+
+	sortByValues(tied, []map[uuid.UUID]int{points, goalDifference, goalsFor}, func(stillTied []*entity.Statistics) {
+		if len(stillTied) < len(tied) {
+			r.miniLeague(stillTied, criteria)
+		} else {
+			r.breakTies(stillTied, criteria)
+		}
+	})
+}
+
+// sortByValues ordena os times por cada mapa de valores em sequência, do maior para o menor, e
+// chama fn com cada grupo que continuar empatado em todos eles.
+func sortByValues(tied []*entity.Statistics, values []map[uuid.UUID]int, fn func([]*entity.Statistics)) {
+	if len(values) == 0 {
+		fn(tied)
+		return
+	}
+
+	value := func(s *entity.Statistics) int { return values[0][s.TeamID] }
+	sort.SliceStable(tied, func(i, j int) bool { return value(tied[i]) > value(tied[j]) })
+	forEachTie(tied, value, func(stillTied []*entity.Statistics) {
+		sortByValues(stillTied, values[1:], fn)
+	})
+}
+
+// forEachTie chama fn para cada sequência de times consecutivos com o mesmo valor.
+func forEachTie(standings []*entity.Statistics, value func(*entity.Statistics) int, fn func([]*entity.Statistics)) {
+	for start := 0; start < len(standings); {
+		end := start + 1
+		for end < len(standings) && value(standings[end]) == value(standings[start]) {
+			end++
+		}
+		if end-start > 1 {
+			fn(standings[start:end])
+		}
+		start = end
+	}
+}
+
+// criterionValues devolve o valor de cada time no critério; valores maiores ficam à frente.
+func (r *standingsRanker) criterionValues(criterion entity.StandingsCriterion, tied []*entity.Statistics) map[uuid.UUID]int {
+	values := make(map[uuid.UUID]int, len(tied))
+	switch criterion {
+	case entity.CriterionGoalDifference:
+		for _, s := range tied {
+			values[s.TeamID] = s.GoalDifference
+		}
+	case entity.CriterionGoalsFor:
+		for _, s := range tied {
+			values[s.TeamID] = s.GoalsFor
+		}
+	case entity.CriterionGoalsAgainst:
+		for _, s := range tied {
+			values[s.TeamID] = -s.GoalsAgainst // Menos gols sofridos fica à frente
+		}
+	case entity.CriterionWins:
+		for _, s := range tied {
+			values[s.TeamID] = s.Wins
+		}
+	case entity.CriterionAwayGoals:
+		for _, match := range r.matches {
+			values[*match.AwayTeamID] += match.ScoreAway
+		}
+	case entity.CriterionHeadToHeadPoints:
+		for teamID, stats := range r.headToHeadTable(tied) {
+			values[teamID] = stats.Points
+		}
+	case entity.CriterionHeadToHeadGoalDifference:
+		for teamID, stats := range r.headToHeadTable(tied) {
+			values[teamID] = stats.GoalDifference
+		}
+	case entity.CriterionDrawingOfLots:
+		for _, s := range tied {
+			values[s.TeamID] = r.lot(s.TeamID)
+		}
+	}
+	return values
+}
+
+// headToHeadTable monta as estatísticas dos times empatados apenas com as partidas entre eles.
+func (r *standingsRanker) headToHeadTable(tied []*entity.Statistics) map[uuid.UUID]*entity.Statistics {
+	table := make(map[uuid.UUID]*entity.Statistics, len(tied))
+	for _, s := range tied {
+		table[s.TeamID] = &entity.Statistics{TeamID: s.TeamID}
+	}
+
+	points := r.championship.Points()
+	for _, match := range r.matches {
+		home, away := table[*match.HomeTeamID], table[*match.AwayTeamID]
+		if home == nil || away == nil {
+			continue
+		}
+		applyMatchResult(home, match, true, points)
+		applyMatchResult(away, match, false, points)
+	}
+	return table
+}
+
+// lot devolve a posição sorteada do time. O sorteio usa a semente do campeonato, de modo
+// que a mesma classificação é sempre devolvida.
+func (r *standingsRanker) lot(teamID uuid.UUID) int {
+	if r.lots == nil {
+		teamIDs := append([]uuid.UUID(nil), r.teamIDs...)
+		sort.Slice(teamIDs, func(i, j int) bool { return teamIDs[i].String() < teamIDs[j].String() })
+		drawRand(r.championship, 0).Shuffle(len(teamIDs), func(i, j int) { teamIDs[i], teamIDs[j] = teamIDs[j], teamIDs[i] })
+
+		r.lots = make(map[uuid.UUID]int, len(teamIDs))
+		for i, id := range teamIDs {
+			r.lots[id] = len(teamIDs) - i
+		}
+	}
+	return r.lots[teamID]
+}
+
+// computeStandings monta a classificação a partir das partidas, na mesma forma das estatísticas
+// gravadas. Os times aparecem agrupados pelo número do grupo, cada grupo já ordenado, e os
+// desclassificados vão para o fim do seu grupo.
+func computeStandings(championship *entity.Championship, matches []*entity.Match, disqualified map[uuid.UUID]bool) []*entity.Statistics {
+	var standings []*entity.Statistics
+	byTeam := make(map[uuid.UUID]*entity.Statistics)
+	teamStats := func(teamID uuid.UUID, groupNumber *int) *entity.Statistics {
+		stats, ok := byTeam[teamID]
+		if !ok {
+			stats = &entity.Statistics{
+				ChampionshipID: championship.ID,
+				TeamID:         teamID,
+				GroupNumber:    groupNumber,
+				Disqualified:   disqualified[teamID],
+			}
+			byTeam[teamID] = stats
+			standings = append(standings, stats)
+		}
+		return stats
+	}
+
+	points := championship.Points()
+	for _, match := range matches {
+		if match.HomeTeamID == nil || !hasStandings(championship, match) {
+			continue
+		}
+		home := teamStats(*match.HomeTeamID, match.GroupNumber)
+
+		// Folga no sistema suíço
+		if match.AwayTeamID == nil {
+			if match.IsCompleted() {
+				applyBye(home, points)
+			}
+			continue
+		}
+
+		away := teamStats(*match.AwayTeamID, match.GroupNumber)
+		if match.Status == entity.MatchStatusFinished {
+			applyMatchResult(home, match, true, points)
+			applyMatchResult(away, match, false, points)
+		}
+	}
+
+	groupOf := func(s *entity.Statistics) int {
+		if s.GroupNumber == nil {
+			return 0
+		}
+		return *s.GroupNumber
+	}
+	sort.SliceStable(standings, func(i, j int) bool { return groupOf(standings[i]) < groupOf(standings[j]) })
+	forEachTie(standings, groupOf, func(group []*entity.Statistics) {
+		sortStandings(championship, group, matches)
+	})
+
+	return standings
+}
+
+// disqualifiedTeams devolve os times marcados como desclassificados nas estatísticas gravadas.
+func disqualifiedTeams(statsList []*entity.Statistics) map[uuid.UUID]bool {
+	disqualified := make(map[uuid.UUID]bool)
+	for _, stats := range statsList {
+		if stats.Disqualified {
+			disqualified[stats.TeamID] = true
+		}
+	}
+	return disqualified
+}
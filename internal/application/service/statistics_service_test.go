@@ -0,0 +1,475 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func finishedMatch(home, away uuid.UUID, scoreHome, scoreAway int) *entity.Match {
+	return &entity.Match{
+		HomeTeamID: &home,
+		AwayTeamID: &away,
+		Status:     entity.MatchStatusFinished,
+		ScoreHome:  scoreHome,
+		ScoreAway:  scoreAway,
+		Phase:      1,
+	}
+}
+
+func TestMatchPoints(t *testing.T) {
+	home, away := uuid.New(), uuid.New()
+
+	win := finishedMatch(home, away, 2, 1)
+	assert.Equal(t, 3, matchPoints(entity.DefaultPointsSystem, win, true))
+	assert.Equal(t, 0, matchPoints(entity.DefaultPointsSystem, win, false))
+
+	draw := finishedMatch(home, away, 1, 1)
+	assert.Equal(t, 1, matchPoints(entity.DefaultPointsSystem, draw, true))
+	assert.Equal(t, 1, matchPoints(entity.DefaultPointsSystem, draw, false))
+
+	// Empate decidido nos pênaltis: 2 pontos para o vencedor da disputa e 1 para o perdedor
+	points := entity.PointsSystem{Win: 3, Draw: 1, ShootoutWin: 2, ShootoutLoss: 1, BonusGoals: 4, Bonus: 1}
+	draw.HasPenalties = true
+	draw.ScoreHomePenalties, draw.ScoreAwayPenalties = 3, 4
+	assert.Equal(t, 1, matchPoints(points, draw, true))
+	assert.Equal(t, 2, matchPoints(points, draw, false))
+
+	// Ponto bônus para quem marca ao menos 4 gols, mesmo na derrota
+	rout := finishedMatch(home, away, 5, 4)
+	assert.Equal(t, 4, matchPoints(points, rout, true))
+	assert.Equal(t, 1, matchPoints(points, rout, false))
+}
+
+func TestSortStandings_TiebreakerChain(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	standings := []*entity.Statistics{
+		{TeamID: a, Points: 4, Wins: 1, GoalsFor: 5, GoalDifference: 2},
+		{TeamID: b, Points: 4, Wins: 1, GoalsFor: 3, GoalDifference: 2},
+		{TeamID: c, Points: 6, Wins: 2, GoalsFor: 2, GoalDifference: 1},
+	}
+	championship := &entity.Championship{Type: entity.ChampionshipTypeLeague}
+
+	// Padrão: saldo de gols e depois gols marcados
+	sortStandings(championship, standings, nil)
+	assert.Equal(t, []uuid.UUID{c, a, b}, standingsTeamIDs(standings))
+
+	// Confronto direto antes dos gols marcados: b venceu a
+	matches := []*entity.Match{finishedMatch(b, a, 1, 0)}
+	championship.Tiebreakers = []entity.StandingsCriterion{entity.CriterionHeadToHeadPoints, entity.CriterionGoalsFor}
+	sortStandings(championship, standings, matches)
+	assert.Equal(t, []uuid.UUID{c, b, a}, standingsTeamIDs(standings))
+
+	// Gols como visitante
+	matches = []*entity.Match{finishedMatch(b, a, 0, 2), finishedMatch(a, b, 0, 0)}
+	championship.Tiebreakers = []entity.StandingsCriterion{entity.CriterionAwayGoals}
+	sortStandings(championship, standings, matches)
+	assert.Equal(t, []uuid.UUID{c, a, b}, standingsTeamIDs(standings))
+}
+
+func TestSortStandings_DrawingOfLotsIsReproducible(t *testing.T) {
+	var standings []*entity.Statistics
+	for i := 0; i < 6; i++ {
+		standings = append(standings, &entity.Statistics{TeamID: uuid.New(), Points: 3})
+	}
+	championship := &entity.Championship{
+		Type:        entity.ChampionshipTypeLeague,
+		DrawSeed:    42,
+		Tiebreakers: []entity.StandingsCriterion{entity.CriterionDrawingOfLots},
+	}
+
+	sortStandings(championship, standings, nil)
+	first := standingsTeamIDs(standings)
+
+	// Reordenar a entrada não altera o resultado do sorteio
+	standings[0], standings[5] = standings[5], standings[0]
+	sortStandings(championship, standings, nil)
+	assert.Equal(t, first, standingsTeamIDs(standings))
+}
+
+func TestSortStandings_DisqualifiedTeamsLast(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	standings := []*entity.Statistics{
+		{TeamID: a, Points: 9, GoalDifference: 5, Disqualified: true},
+		{TeamID: b, Points: 3},
+		{TeamID: c, Points: 0, GoalDifference: 1, Disqualified: true},
+		{TeamID: d, Points: 3, GoalDifference: 2},
+	}
+	championship := &entity.Championship{Type: entity.ChampionshipTypeLeague}
+
+	sortStandings(championship, standings, nil)
+	assert.Equal(t, []uuid.UUID{d, b, a, c}, standingsTeamIDs(standings))
+}
+
+func standingsTeamIDs(standings []*entity.Statistics) []uuid.UUID {
+	teamIDs := make([]uuid.UUID, len(standings))
+	for i, s := range standings {
+		teamIDs[i] = s.TeamID
+	}
+	return teamIDs
+}
+
+func TestSortStandings_HeadToHeadMiniLeague(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	// a, b e c empatam em pontos. Na mini-classificação entre os três a soma 6 pontos e b e c
+	// ficam iguais em pontos, saldo e gols marcados. A nova mini-classificação, só com o jogo
+	// entre b e c, coloca b à frente apesar do saldo geral melhor de c.
+	matches := []*entity.Match{
+		finishedMatch(b, c, 1, 0),
+		finishedMatch(a, b, 2, 0),
+		finishedMatch(c, a, 1, 0),
+		finishedMatch(a, c, 1, 0),
+		finishedMatch(d, c, 0, 5),
+	}
+	standings := []*entity.Statistics{
+		{TeamID: c, Points: 7, GoalDifference: 2},
+		{TeamID: b, Points: 7, GoalDifference: 0},
+		{TeamID: a, Points: 7, GoalDifference: 3},
+		{TeamID: d, Points: 0, GoalDifference: -5},
+	}
+	championship := &entity.Championship{
+		Type:        entity.ChampionshipTypeLeague,
+		Tiebreakers: []entity.StandingsCriterion{entity.CriterionHeadToHead, entity.CriterionGoalDifference},
+	}
+
+	sortStandings(championship, standings, matches)
+	assert.Equal(t, []uuid.UUID{a, b, c, d}, standingsTeamIDs(standings))
+}
+
+func TestSortStandings_HeadToHeadFallsBackToGoalDifference(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	matches := []*entity.Match{finishedMatch(a, b, 1, 1)}
+	standings := []*entity.Statistics{
+		{TeamID: a, Points: 4, GoalDifference: 1},
+		{TeamID: b, Points: 4, GoalDifference: 2},
+	}
+	championship := &entity.Championship{
+		Type:        entity.ChampionshipTypeLeague,
+		Tiebreakers: []entity.StandingsCriterion{entity.CriterionHeadToHead, entity.CriterionGoalDifference},
+	}
+
+	sortStandings(championship, standings, matches)
+	assert.Equal(t, []uuid.UUID{b, a}, standingsTeamIDs(standings))
+}
+
+func TestSortStandings_HeadToHeadSubsetFallsBackToGoalDifference(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	// a vence os dois confrontos e sai da mini-classificação; b e c continuam iguais, também
+	// no jogo entre eles, e são separados pelo saldo geral
+	matches := []*entity.Match{
+		finishedMatch(a, b, 1, 0),
+		finishedMatch(a, c, 1, 0),
+		finishedMatch(b, c, 1, 1),
+	}
+	standings := []*entity.Statistics{
+		{TeamID: b, Points: 6, GoalDifference: 1},
+		{TeamID: c, Points: 6, GoalDifference: 4},
+		{TeamID: a, Points: 6, GoalDifference: 0},
+	}
+	championship := &entity.Championship{
+		Type:        entity.ChampionshipTypeLeague,
+		Tiebreakers: []entity.StandingsCriterion{entity.CriterionHeadToHead, entity.CriterionGoalDifference},
+	}
+
+	sortStandings(championship, standings, matches)
+	assert.Equal(t, []uuid.UUID{a, c, b}, standingsTeamIDs(standings))
+}
+
+func TestComputeStandings_FromMatches(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	group1, group2 := 1, 2
+	championship := &entity.Championship{Type: entity.ChampionshipTypeGroupsKnockout}
+
+	inGroup := func(match *entity.Match, group *int) *entity.Match {
+		match.GroupNumber = group
+		return match
+	}
+	scheduled := finishedMatch(c, d, 0, 0)
+	scheduled.Status = entity.MatchStatusScheduled
+	knockout := finishedMatch(a, d, 0, 9)
+	knockout.Phase = 2
+
+	standings := computeStandings(championship, []*entity.Match{
+		inGroup(finishedMatch(a, b, 0, 2), &group1),
+		inGroup(scheduled, &group2),
+		knockout,
+	}, nil)
+
+	assert.Equal(t, []uuid.UUID{b, a, c, d}, standingsTeamIDs(standings))
+	assert.Equal(t, 3, standings[0].Points)
+	assert.Equal(t, -2, standings[1].GoalDifference)
+	assert.Equal(t, 0, standings[2].MatchesPlayed, "Partidas ainda não jogadas não contam")
+	assert.Equal(t, 0, standings[3].GoalsFor, "Partidas do mata-mata não contam")
+}
+
+func cupStatisticsByTeam(statsList []*entity.CupStatistics) map[uuid.UUID]*entity.CupStatistics {
+	byTeam := make(map[uuid.UUID]*entity.CupStatistics, len(statsList))
+	for _, stats := range statsList {
+		byTeam[stats.TeamID] = stats
+	}
+	return byTeam
+}
+
+func TestComputeCupStatistics_ExtraTimeShootoutsAndThirdPlace(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	championship := &entity.Championship{Type: entity.ChampionshipTypeCup}
+
+	thirdPlace := finishedMatch(b, c, 2, 0)
+	thirdPlace.ID = uuid.New()
+	thirdPlace.Phase = 2
+	thirdPlace.Bracket = entity.MatchBracketThirdPlace
+	thirdPlace.WinnerTeamID = &b
+
+	// a vence na prorrogação e d nos pênaltis; os perdedores vão para a disputa de terceiro lugar
+	semiFinal1 := finishedMatch(a, b, 1, 1)
+	semiFinal1.ID = uuid.New()
+	semiFinal1.HasExtraTime = true
+	semiFinal1.ScoreHomeExtraTime = 1
+	semiFinal1.WinnerTeamID = &a
+	semiFinal1.LoserMatchID = &thirdPlace.ID
+	semiFinal2 := finishedMatch(c, d, 0, 0)
+	semiFinal2.ID = uuid.New()
+	semiFinal2.HasPenalties = true
+	semiFinal2.ScoreHomePenalties = 3
+	semiFinal2.ScoreAwayPenalties = 4
+	semiFinal2.WinnerTeamID = &d
+	semiFinal2.LoserMatchID = &thirdPlace.ID
+
+	final := finishedMatch(a, d, 0, 0)
+	final.ID = uuid.New()
+	final.Phase = 2
+	final.Status = entity.MatchStatusScheduled
+
+	statsList := computeCupStatistics(championship, []*entity.Match{final, thirdPlace, semiFinal1, semiFinal2})
+
+	teamIDs := make([]uuid.UUID, 0, len(statsList))
+	for _, stats := range statsList {
+		teamIDs = append(teamIDs, stats.TeamID)
+	}
+	assert.Equal(t, []uuid.UUID{a, d, b, c}, teamIDs, "Finalistas à frente, eliminados por último")
+
+	byTeam := cupStatisticsByTeam(statsList)
+	assert.Equal(t, 1, byTeam[a].MatchesPlayed)
+	assert.Equal(t, 1, byTeam[a].Wins)
+	assert.Equal(t, 2, byTeam[a].GoalsFor, "Gols da prorrogação contam")
+	assert.Equal(t, 1, byTeam[a].ExtraTimeWins)
+	assert.Equal(t, 2, byTeam[a].FurthestPhase)
+	assert.False(t, byTeam[a].Eliminated)
+
+	assert.Equal(t, 1, byTeam[d].Draws, "Jogo decidido nos pênaltis conta como empate")
+	assert.Equal(t, 1, byTeam[d].ShootoutWins)
+	assert.False(t, byTeam[d].Eliminated)
+
+	assert.Equal(t, 2, byTeam[b].MatchesPlayed)
+	assert.Equal(t, 1, byTeam[b].ExtraTimeLosses)
+	assert.True(t, byTeam[b].Eliminated, "A disputa de terceiro lugar não mantém o time na briga pelo título")
+	assert.Equal(t, 1, byTeam[c].ShootoutLosses)
+	assert.True(t, byTeam[c].Eliminated)
+}
+
+func TestComputeCupStatistics_TwoLeggedTiesAndDoubleElimination(t *testing.T) {
+	x, y := uuid.New(), uuid.New()
+	cup := &entity.Championship{Type: entity.ChampionshipTypeCup}
+
+	firstLeg := finishedMatch(x, y, 1, 0)
+	firstLeg.ID = uuid.New()
+	firstLeg.Leg = 1
+	secondLeg := finishedMatch(y, x, 1, 0)
+	secondLeg.ID = uuid.New()
+	secondLeg.Leg = 2
+	secondLeg.FirstLegMatchID = &firstLeg.ID
+	secondLeg.HasPenalties = true
+	secondLeg.ScoreHomePenalties = 5
+	secondLeg.ScoreAwayPenalties = 4
+	secondLeg.WinnerTeamID = &y
+
+	byTeam := cupStatisticsByTeam(computeCupStatistics(cup, []*entity.Match{firstLeg, secondLeg}))
+	assert.Equal(t, 2, byTeam[x].MatchesPlayed)
+	assert.Equal(t, 1, byTeam[x].Wins)
+	assert.Equal(t, 1, byTeam[x].ShootoutLosses)
+	assert.True(t, byTeam[x].Eliminated)
+	assert.False(t, byTeam[y].Eliminated)
+
+	// Na dupla eliminação a primeira derrota leva à chave dos perdedores, e o campeão da chave
+	// dos vencedores ainda tem a final de desempate
+	w, l := uuid.New(), uuid.New()
+	doubleElimination := &entity.Championship{Type: entity.ChampionshipTypeCup, DoubleElimination: true, BracketReset: true}
+	losersMatch := finishedMatch(l, uuid.New(), 0, 0)
+	losersMatch.ID = uuid.New()
+	losersMatch.Bracket = entity.MatchBracketLosers
+	losersMatch.Status = entity.MatchStatusScheduled
+	winnersMatch := finishedMatch(w, l, 2, 0)
+	winnersMatch.ID = uuid.New()
+	winnersMatch.Bracket = entity.MatchBracketWinners
+	winnersMatch.WinnerTeamID = &w
+	winnersMatch.LoserMatchID = &losersMatch.ID
+	grandFinal := finishedMatch(w, l, 0, 1)
+	grandFinal.ID = uuid.New()
+	grandFinal.Phase = 3
+	grandFinal.Bracket = entity.MatchBracketGrandFinal
+	grandFinal.WinnerTeamID = &l
+
+	byTeam = cupStatisticsByTeam(computeCupStatistics(doubleElimination, []*entity.Match{winnersMatch, losersMatch, grandFinal}))
+	assert.False(t, byTeam[w].Eliminated)
+	assert.False(t, byTeam[l].Eliminated)
+	assert.Equal(t, 3, byTeam[w].FurthestPhase)
+}
+
+func TestComputeBracketPath(t *testing.T) {
+	a, b, e := uuid.New(), uuid.New(), uuid.New()
+	championship := &entity.Championship{ID: uuid.New(), Type: entity.ChampionshipTypeCup}
+
+	bye := &entity.Match{ID: uuid.New(), HomeTeamID: &e, Status: entity.MatchStatusBye, WinnerTeamID: &e, Phase: 1}
+	firstRound := finishedMatch(a, b, 3, 1)
+	firstRound.ID = uuid.New()
+	firstRound.WinnerTeamID = &a
+	semiFinal := finishedMatch(e, a, 0, 0)
+	semiFinal.ID = uuid.New()
+	semiFinal.Phase = 2
+	semiFinal.Status = entity.MatchStatusScheduled
+
+	path := computeBracketPath(championship, []*entity.Match{semiFinal, firstRound, bye}, a)
+	assert.Equal(t, championship.ID, path.ChampionshipID)
+	assert.Equal(t, 2, path.FurthestPhase)
+	assert.False(t, path.Eliminated)
+	require.Len(t, path.Steps, 2)
+
+	assert.Equal(t, firstRound.ID, path.Steps[0].MatchID)
+	assert.Equal(t, &b, path.Steps[0].OpponentID)
+	assert.Equal(t, 3, path.Steps[0].GoalsFor)
+	assert.Equal(t, entity.BracketResultWon, path.Steps[0].Result)
+	assert.False(t, path.Steps[1].Home)
+	assert.Equal(t, entity.BracketResultPending, path.Steps[1].Result)
+
+	path = computeBracketPath(championship, []*entity.Match{semiFinal, firstRound, bye}, e)
+	require.Len(t, path.Steps, 2)
+	assert.Equal(t, entity.BracketResultBye, path.Steps[0].Result)
+	assert.Nil(t, path.Steps[0].OpponentID)
+
+	path = computeBracketPath(championship, []*entity.Match{semiFinal, firstRound, bye}, b)
+	assert.True(t, path.Eliminated)
+	assert.Equal(t, 1, path.Steps[0].GoalsFor)
+	assert.Equal(t, entity.BracketResultLost, path.Steps[0].Result)
+}
+
+func snapshotPositions(snapshots []*entity.StandingsSnapshot, round int) map[uuid.UUID]int {
+	positions := make(map[uuid.UUID]int)
+	for _, snapshot := range snapshots {
+		if snapshot.Round == round {
+			positions[snapshot.TeamID] = snapshot.Position
+		}
+	}
+	return positions
+}
+
+func TestComputeStandingsSnapshots_PerRound(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	championship := &entity.Championship{Type: entity.ChampionshipTypeLeague}
+
+	inRound := func(match *entity.Match, round int) *entity.Match {
+		match.Round = round
+		return match
+	}
+	postponed := inRound(finishedMatch(c, d, 0, 0), 1)
+	postponed.Status = entity.MatchStatusPostponed
+	upcoming := inRound(finishedMatch(a, d, 0, 0), 3)
+	upcoming.Status = entity.MatchStatusScheduled
+	matches := []*entity.Match{
+		inRound(finishedMatch(a, b, 2, 0), 1),
+		postponed,
+		inRound(finishedMatch(a, c, 0, 1), 2),
+		inRound(finishedMatch(b, d, 1, 1), 2),
+		upcoming,
+	}
+
+	snapshots := computeStandingsSnapshots(championship, matches, nil)
+	require.Len(t, snapshots, 8, "Uma tabela com os quatro times para cada rodada encerrada")
+
+	round1 := snapshotPositions(snapshots, 1)
+	assert.Len(t, round1, 4, "Times sem partida na rodada também aparecem")
+	assert.Equal(t, 1, round1[a])
+	assert.Equal(t, 4, round1[b])
+
+	round2 := snapshotPositions(snapshots, 2)
+	assert.Equal(t, 1, round2[a], "a e c empatam em pontos e saldo; a marcou mais gols")
+	assert.Equal(t, 2, round2[c])
+	assert.Equal(t, 3, round2[d])
+	assert.Equal(t, 4, round2[b])
+
+	// A partida adiada conta na rodada a que pertence assim que for disputada
+	postponed.Status = entity.MatchStatusFinished
+	postponed.ScoreHome = 3
+	snapshots = computeStandingsSnapshots(championship, matches, nil)
+	assert.Equal(t, 1, snapshotPositions(snapshots, 1)[c])
+	assert.Equal(t, 1, snapshotPositions(snapshots, 2)[c])
+	for _, snapshot := range snapshots {
+		if snapshot.Round == 1 && snapshot.TeamID == c {
+			assert.Equal(t, 1, snapshot.MatchesPlayed)
+			assert.Equal(t, 3, snapshot.Points)
+		}
+	}
+}
+
+func TestComputeStandingsSnapshotsFromRound_MatchesFullHistory(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	championship := &entity.Championship{Type: entity.ChampionshipTypeLeague}
+
+	inRound := func(match *entity.Match, round int) *entity.Match {
+		match.Round = round
+		return match
+	}
+	matches := []*entity.Match{
+		inRound(finishedMatch(a, b, 2, 0), 1),
+		inRound(finishedMatch(c, d, 1, 1), 1),
+		inRound(finishedMatch(a, c, 0, 1), 2),
+		inRound(finishedMatch(b, d, 3, 1), 2),
+		inRound(finishedMatch(a, d, 1, 0), 3),
+		inRound(finishedMatch(b, c, 0, 0), 3),
+	}
+
+	full := computeStandingsSnapshots(championship, matches, nil)
+	partial := computeStandingsSnapshotsFromRound(championship, matches, nil, 2)
+	require.Len(t, partial, 8, "Só as tabelas das rodadas 2 e 3")
+
+	var expected []*entity.StandingsSnapshot
+	for _, snapshot := range full {
+		if snapshot.Round >= 2 {
+			expected = append(expected, snapshot)
+		}
+	}
+	assert.Equal(t, expected, partial)
+}
+
+func TestComputeStandingsSnapshots_PositionsWithinGroups(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	group1, group2 := 1, 2
+	championship := &entity.Championship{Type: entity.ChampionshipTypeGroupsKnockout}
+
+	groupMatch := func(match *entity.Match, group *int) *entity.Match {
+		match.GroupNumber = group
+		match.Round = 1
+		return match
+	}
+	knockout := finishedMatch(a, c, 5, 0)
+	knockout.Phase = 2
+
+	matches := []*entity.Match{
+		groupMatch(finishedMatch(a, b, 1, 0), &group1),
+		groupMatch(finishedMatch(c, d, 0, 2), &group2),
+		knockout,
+	}
+	snapshots := computeStandingsSnapshots(championship, matches, nil)
+
+	positions := snapshotPositions(snapshots, 1)
+	assert.Equal(t, map[uuid.UUID]int{a: 1, b: 2, d: 1, c: 2}, positions)
+	assert.Equal(t, 0, snapshots[0].GoalsAgainst, "Partidas do mata-mata não contam")
+
+	// Times desclassificados ficam no fim do grupo em todas as rodadas
+	snapshots = computeStandingsSnapshots(championship, matches, map[uuid.UUID]bool{a: true})
+	assert.Equal(t, map[uuid.UUID]int{b: 1, a: 2, d: 1, c: 2}, snapshotPositions(snapshots, 1))
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type SuspensionService interface {
+	RebuildSuspensions(ctx context.Context, championshipID uuid.UUID) error
+	RebuildSuspensionsWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error
+	ListSuspensions(ctx context.Context, championshipID uuid.UUID, activeOnly bool) ([]*entity.Suspension, error)
+	SuspendedPlayers(ctx context.Context, match *entity.Match) (map[uuid.UUID]*entity.Suspension, error)
+}
+
+type suspensionService struct {
+	suspensionRepo   repository.SuspensionRepository
+	championshipRepo repository.ChampionshipRepository
+	matchRepo        repository.MatchRepository
+	matchEventRepo   repository.MatchEventRepository
+}
+
+func NewSuspensionService(
+	suspensionRepo repository.SuspensionRepository,
+	championshipRepo repository.ChampionshipRepository,
+	matchRepo repository.MatchRepository,
+	matchEventRepo repository.MatchEventRepository,
+) SuspensionService {
+	return &suspensionService{
+		suspensionRepo:   suspensionRepo,
+		championshipRepo: championshipRepo,
+		matchRepo:        matchRepo,
+		matchEventRepo:   matchEventRepo,
+	}
+}
+
+// RebuildSuspensions recalcula as suspensões do campeonato a partir dos cartões registrados nas
+// partidas encerradas, em uma única transação.
+func (s *suspensionService) RebuildSuspensions(ctx context.Context, championshipID uuid.UUID) (err error) {
+	championship, err := s.championshipRepo.GetByID(ctx, championshipID)
+	if err != nil {
+		return err
+	}
+	if championship == nil {
+		return fmt.Errorf("campeonato com ID %s não encontrado", championshipID)
+	}
+
+	tx, err := s.suspensionRepo.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	return s.RebuildSuspensionsWithTx(ctx, tx, championship)
+}
+
+// RebuildSuspensionsWithTx recalcula as suspensões dentro de uma transação já aberta, para que
+// um cartão recebido passe a valer junto com o resultado da partida.
+func (s *suspensionService) RebuildSuspensionsWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error {
+	matches, err := s.matchRepo.GetByChampionshipIDWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+	events, err := s.matchEventRepo.ListByChampionshipIDWithTx(ctx, tx, championship.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.suspensionRepo.DeleteByChampionshipWithTx(ctx, tx, championship.ID); err != nil {
+		return err
+	}
+	for _, suspension := range computeSuspensions(championship.Discipline(), matches, events) {
+		suspension.ID = uuid.New()
+		suspension.ChampionshipID = championship.ID
+		suspension.CreatedAt = time.Now()
+		suspension.UpdatedAt = time.Now()
+
+		if err := s.suspensionRepo.CreateWithTx(ctx, tx, suspension); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *suspensionService) ListSuspensions(ctx context.Context, championshipID uuid.UUID, activeOnly bool) ([]*entity.Suspension, error) {
+	suspensions, err := s.suspensionRepo.ListByChampionship(ctx, championshipID)
+	if err != nil {
+		return nil, err
+	}
+	if !activeOnly {
+		return suspensions, nil
+	}
+
+	active := make([]*entity.Suspension, 0, len(suspensions))
+	for _, suspension := range suspensions {
+		if suspension.IsActive() {
+			active = append(active, suspension)
+		}
+	}
+	return active, nil
+}
+
+// SuspendedPlayers devolve, por jogador, as suspensões que o impedem de atuar na partida.
+func (s *suspensionService) SuspendedPlayers(ctx context.Context, match *entity.Match) (map[uuid.UUID]*entity.Suspension, error) {
+	suspensions, err := s.suspensionRepo.ListByChampionship(ctx, match.ChampionshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	suspended := make(map[uuid.UUID]*entity.Suspension)
+	for _, suspension := range suspensions {
+		if hasTeam(match, suspension.TeamID) && suspension.Covers(match) {
+			suspended[suspension.PlayerID] = suspension
+		}
+	}
+	return suspended, nil
+}
+
+// computeSuspensions percorre as partidas disputadas em ordem e aplica as regras de suspensão.
+// Cada partida do time conta para as suspensões aplicadas antes dela, inclusive as decididas
+// por W.O. Os amarelos de um jogador expulso na partida não entram no acúmulo, já que a
+// expulsão tem suspensão própria.
+func computeSuspensions(rules entity.DisciplinaryRules, matches []*entity.Match, events []*entity.MatchEvent) []*entity.Suspension {
+	eventsByMatch := make(map[uuid.UUID][]*entity.MatchEvent)
+	for _, event := range events {
+		eventsByMatch[event.MatchID] = append(eventsByMatch[event.MatchID], event)
+	}
+
+	var played []*entity.Match
+	for _, match := range matches {
+		if match.Status == entity.MatchStatusFinished && match.HomeTeamID != nil && match.AwayTeamID != nil {
+			played = append(played, match)
+		}
+	}
+	sortMatchesByRound(played)
+
+	var suspensions []*entity.Suspension
+	yellowCards := make(map[uuid.UUID]int)
+	for _, match := range played {
+		for _, suspension := range suspensions {
+			if suspension.IsActive() && hasTeam(match, suspension.TeamID) {
+				suspension.ServedMatchIDs = append(suspension.ServedMatchIDs, match.ID)
+			}
+		}
+
+		yellows := make(map[uuid.UUID]int)
+		sentOff := make(map[uuid.UUID]bool)
+		var players []uuid.UUID
+		teams := make(map[uuid.UUID]uuid.UUID)
+		for _, event := range eventsByMatch[match.ID] {
+			if event.PlayerID == nil {
+				continue
+			}
+			playerID := *event.PlayerID
+
+			switch event.Type {
+			case entity.MatchEventYellowCard:
+				yellows[playerID]++
+			case entity.MatchEventRedCard:
+				if !sentOff[playerID] {
+					sentOff[playerID] = true
+					suspensions = append(suspensions, newSuspension(match, playerID, event.TeamID, entity.SuspensionRedCard, rules))
+				}
+			default:
+				continue
+			}
+			if _, ok := teams[playerID]; !ok {
+				teams[playerID] = event.TeamID
+				players = append(players, playerID)
+			}
+		}
+
+		if rules.YellowCards == 0 {
+			continue
+		}
+		for _, playerID := range players {
+			if sentOff[playerID] || yellows[playerID] == 0 {
+				continue
+			}
+			yellowCards[playerID] += yellows[playerID]
+			if yellowCards[playerID] >= rules.YellowCards {
+				yellowCards[playerID] -= rules.YellowCards
+				suspensions = append(suspensions, newSuspension(match, playerID, teams[playerID], entity.SuspensionYellowCards, rules))
+			}
+		}
+	}
+
+	return suspensions
+}
+
+func newSuspension(match *entity.Match, playerID, teamID uuid.UUID, reason entity.SuspensionReason, rules entity.DisciplinaryRules) *entity.Suspension {
+	return &entity.Suspension{
+		PlayerID: playerID,
+		TeamID:   teamID,
+		MatchID:  match.ID,
+		Reason:   reason,
+		Matches:  rules.Matches,
+	}
+}
+
+// sortMatchesByRound ordena as partidas pela ordem em que são disputadas: fase, rodada e data.
+func sortMatchesByRound(matches []*entity.Match) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.Phase != b.Phase {
+			return a.Phase < b.Phase
+		}
+		if a.Round != b.Round {
+			return a.Round < b.Round
+		}
+		if a.MatchDate != nil && b.MatchDate != nil && !a.MatchDate.Equal(*b.MatchDate) {
+			return a.MatchDate.Before(*b.MatchDate)
+		}
+		return a.Leg < b.Leg
+	})
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"champi-maker/internal/domain/entity"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeSuspensions(t *testing.T) {
+	home, away := uuid.New(), uuid.New()
+	booked, sentOff := uuid.New(), uuid.New()
+
+	round := func(n int) *entity.Match {
+		match := finishedMatch(home, away, 1, 0)
+		match.ID = uuid.New()
+		match.Round = n
+		return match
+	}
+	first, second, third, fourth := round(1), round(2), round(3), round(4)
+	// O W.O. também conta como partida cumprida
+	third.Walkover = true
+
+	yellow := func(match *entity.Match, player uuid.UUID) *entity.MatchEvent {
+		return &entity.MatchEvent{MatchID: match.ID, TeamID: home, Type: entity.MatchEventYellowCard, PlayerID: &player}
+	}
+	events := []*entity.MatchEvent{
+		yellow(first, booked),
+		yellow(second, booked),
+		// Dois amarelos e o vermelho na mesma partida: só a expulsão conta
+		yellow(second, sentOff),
+		yellow(second, sentOff),
+		{MatchID: second.ID, TeamID: home, Type: entity.MatchEventRedCard, PlayerID: &sentOff},
+	}
+
+	rules := entity.DisciplinaryRules{YellowCards: 2, Matches: 1}
+	// A ordem de entrada não importa, as partidas são percorridas por rodada
+	suspensions := computeSuspensions(rules, []*entity.Match{fourth, second, third, first}, events)
+	require.Len(t, suspensions, 2)
+
+	assert.Equal(t, sentOff, suspensions[0].PlayerID)
+	assert.Equal(t, entity.SuspensionRedCard, suspensions[0].Reason)
+	assert.Equal(t, second.ID, suspensions[0].MatchID)
+	assert.Equal(t, []uuid.UUID{third.ID}, suspensions[0].ServedMatchIDs)
+
+	assert.Equal(t, booked, suspensions[1].PlayerID)
+	assert.Equal(t, entity.SuspensionYellowCards, suspensions[1].Reason)
+	assert.Equal(t, home, suspensions[1].TeamID)
+	assert.Equal(t, []uuid.UUID{third.ID}, suspensions[1].ServedMatchIDs)
+	assert.False(t, suspensions[1].IsActive())
+
+	// Sem acúmulo de amarelos, só as expulsões suspendem; a suspensão de duas partidas segue
+	// ativa enquanto o time não joga a segunda
+	rules = entity.DisciplinaryRules{YellowCards: 0, Matches: 2}
+	suspensions = computeSuspensions(rules, []*entity.Match{first, second, third}, events)
+	require.Len(t, suspensions, 1)
+	assert.True(t, suspensions[0].IsActive())
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"math"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -10,30 +11,228 @@ import (
 type ChampionshipType string
 type TiebreakerMethod string
 type ProgressionType string
+type ChampionshipStatus string
+type StandingsCriterion string
+type DisqualificationRule string
 
 const (
-	ChampionshipTypeLeague ChampionshipType = "league"
-	ChampionshipTypeCup    ChampionshipType = "cup"
+	ChampionshipTypeLeague         ChampionshipType = "league"
+	ChampionshipTypeCup            ChampionshipType = "cup"
+	ChampionshipTypeGroupsKnockout ChampionshipType = "groups_knockout"
+	ChampionshipTypeSwiss          ChampionshipType = "swiss"
 
 	TiebreakerPenalties TiebreakerMethod = "penalties"
 	TiebreakerExtraTime TiebreakerMethod = "extra_time"
 
 	ProgressionFixed      ProgressionType = "fixed"
 	ProgressionRandomDraw ProgressionType = "random_draw"
+
+	ChampionshipStatusDraft        ChampionshipStatus = "draft"
+	ChampionshipStatusRegistration ChampionshipStatus = "registration"
+	ChampionshipStatusInProgress   ChampionshipStatus = "in_progress"
+	ChampionshipStatusFinished     ChampionshipStatus = "finished"
+	ChampionshipStatusCancelled    ChampionshipStatus = "cancelled"
+
+	CriterionGoalDifference           StandingsCriterion = "goal_difference"
+	CriterionGoalsFor                 StandingsCriterion = "goals_for"
+	CriterionGoalsAgainst             StandingsCriterion = "goals_against"
+	CriterionWins                     StandingsCriterion = "wins"
+	CriterionAwayGoals                StandingsCriterion = "away_goals"
+	CriterionHeadToHead               StandingsCriterion = "head_to_head" // Mini-classificação entre os empatados
+	CriterionHeadToHeadPoints         StandingsCriterion = "head_to_head_points"
+	CriterionHeadToHeadGoalDifference StandingsCriterion = "head_to_head_goal_difference"
+	CriterionDrawingOfLots            StandingsCriterion = "drawing_of_lots"
+
+	DisqualificationVoid  DisqualificationRule = "void"  // Partidas do time desclassificado são anuladas
+	DisqualificationAward DisqualificationRule = "award" // Partidas pendentes são dadas aos adversários por W.O.
 )
 
+// PointsSystem define a pontuação da classificação por pontos. ShootoutWin e ShootoutLoss
+// substituem os pontos do empate quando a partida é decidida nos pênaltis. Bonus é somado
+// ao time que marcar ao menos BonusGoals gols na partida (BonusGoals zero desativa o bônus).
+type PointsSystem struct {
+	Win          int `json:"win" validate:"gte=0"`
+	Draw         int `json:"draw" validate:"gte=0"`
+	Loss         int `json:"loss" validate:"gte=0"`
+	ShootoutWin  int `json:"shootout_win" validate:"gte=0"`
+	ShootoutLoss int `json:"shootout_loss" validate:"gte=0"`
+	BonusGoals   int `json:"bonus_goals" validate:"gte=0"`
+	Bonus        int `json:"bonus" validate:"gte=0"`
+}
+
+// DisciplinaryRules define as suspensões automáticas: o jogador que acumula YellowCards cartões
+// amarelos ou recebe um cartão vermelho fica fora das Matches partidas seguintes do time.
+// YellowCards zero desativa a suspensão por acúmulo de amarelos.
+type DisciplinaryRules struct {
+	YellowCards int `json:"yellow_cards" validate:"gte=0"`
+	Matches     int `json:"matches" validate:"gte=1"`
+}
+
+// DefaultDisciplinaryRules são as suspensões usadas quando o campeonato não define as suas:
+// uma partida a cada 3 amarelos ou por cartão vermelho.
+var DefaultDisciplinaryRules = DisciplinaryRules{YellowCards: 3, Matches: 1}
+
+// SquadRules define o tamanho das escalações: de MinStarters a Starters titulares e até
+// Substitutes reservas por partida.
+type SquadRules struct {
+	Starters    int `json:"starters" validate:"gte=1,lte=11"`
+	MinStarters int `json:"min_starters" validate:"gte=1,ltefield=Starters"`
+	Substitutes int `json:"substitutes" validate:"gte=0"`
+}
+
+// DefaultSquadRules são as escalações usadas quando o campeonato não define as suas: 11
+// titulares, ao menos 7 para a partida começar, e até 7 reservas.
+var DefaultSquadRules = SquadRules{Starters: 11, MinStarters: 7, Substitutes: 7}
+
+// DefaultPointsSystem é a pontuação usada quando o campeonato não define a sua: 3 pontos
+// pela vitória e 1 pelo empate, inclusive quando decidido nos pênaltis.
+var DefaultPointsSystem = PointsSystem{Win: 3, Draw: 1, ShootoutWin: 1, ShootoutLoss: 1}
+
+// DefaultStandingsTiebreakers são os critérios de desempate usados quando o campeonato
+// não define os seus. O confronto direto (CriterionHeadToHead) não faz parte do padrão; o
+// campeonato que o quiser deve incluí-lo em Tiebreakers.
+var DefaultStandingsTiebreakers = []StandingsCriterion{CriterionGoalDifference, CriterionGoalsFor}
+
+// championshipTransitions lista, para cada status, os status que podem vir em seguida.
+// Campeonatos encerrados ou cancelados não mudam mais de status.
+var championshipTransitions = map[ChampionshipStatus][]ChampionshipStatus{
+	ChampionshipStatusDraft:        {ChampionshipStatusRegistration, ChampionshipStatusInProgress, ChampionshipStatusCancelled},
+	ChampionshipStatusRegistration: {ChampionshipStatusInProgress, ChampionshipStatusCancelled},
+	ChampionshipStatusInProgress:   {ChampionshipStatusFinished, ChampionshipStatusCancelled},
+}
+
 type Championship struct {
-	ID               uuid.UUID        `json:"id" validate:"required"`
-	Name             string           `json:"name" validate:"required,min=2,max=100"`
-	Type             ChampionshipType `json:"type" validate:"required,oneof=league cup"`
-	TiebreakerMethod TiebreakerMethod `json:"tiebreaker_method" validate:"required,oneof=penalties extra_time"`
-	ProgressionType  ProgressionType  `json:"progression_type" validate:"required,oneof=fixed random_draw"`
-	Phases           int              `json:"phases"`
-	CreatedAt        time.Time        `json:"created_at" validate:"required"`
-	UpdatedAt        time.Time        `json:"updated_at" validate:"required"`
+	ID                   uuid.UUID            `json:"id" validate:"required"`
+	Name                 string               `json:"name" validate:"required,min=2,max=100"`
+	Type                 ChampionshipType     `json:"type" validate:"required,oneof=league cup groups_knockout swiss"`
+	TiebreakerMethod     TiebreakerMethod     `json:"tiebreaker_method" validate:"required,oneof=penalties extra_time"`
+	ProgressionType      ProgressionType      `json:"progression_type" validate:"required,oneof=fixed random_draw"`
+	Status               ChampionshipStatus   `json:"status" validate:"omitempty,oneof=draft registration in_progress finished cancelled"`
+	Phases               int                  `json:"phases"`
+	NumGroups            int                  `json:"num_groups" validate:"gte=0"`
+	QualifiersPerGroup   int                  `json:"qualifiers_per_group" validate:"gte=0"`
+	Legs                 int                  `json:"legs" validate:"omitempty,oneof=1 2 4"`
+	SwissRounds          int                  `json:"swiss_rounds" validate:"gte=0"`
+	DoubleElimination    bool                 `json:"double_elimination"`
+	BracketReset         bool                 `json:"bracket_reset"`
+	ThirdPlaceMatch      bool                 `json:"third_place_match"`
+	TwoLeggedTies        bool                 `json:"two_legged_ties"`
+	TwoLeggedPhases      []int                `json:"two_legged_phases,omitempty" validate:"dive,gte=1"`
+	AwayGoals            bool                 `json:"away_goals"`
+	Seeds                []uuid.UUID          `json:"seeds,omitempty"`
+	DrawSeed             int64                `json:"draw_seed,omitempty"`
+	PointsSystem         *PointsSystem        `json:"points_system,omitempty" validate:"omitempty"`
+	Tiebreakers          []StandingsCriterion `json:"tiebreakers,omitempty" validate:"dive,oneof=goal_difference goals_for goals_against wins away_goals head_to_head head_to_head_points head_to_head_goal_difference drawing_of_lots"`
+	DisqualificationRule DisqualificationRule `json:"disqualification_rule,omitempty" validate:"omitempty,oneof=void award"`
+	DisciplinaryRules    *DisciplinaryRules   `json:"disciplinary_rules,omitempty" validate:"omitempty"`
+	SquadRules           *SquadRules          `json:"squad_rules,omitempty" validate:"omitempty"`
+	CreatedAt            time.Time            `json:"created_at" validate:"required"`
+	UpdatedAt            time.Time            `json:"updated_at" validate:"required"`
 }
 
 func (c *Championship) Validate() error {
 	validate := validator.New()
 	return validate.Struct(c)
 }
+
+// CanTransitionTo indica se o campeonato pode passar do status atual para o informado.
+func (c *Championship) CanTransitionTo(status ChampionshipStatus) bool {
+	for _, next := range championshipTransitions[c.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEditable indica se as configurações e os participantes ainda podem ser alterados,
+// o que só vale antes do início das partidas.
+func (c *Championship) IsEditable() bool {
+	return c.Status == ChampionshipStatusDraft || c.Status == ChampionshipStatusRegistration
+}
+
+// Points devolve a pontuação do campeonato, ou a pontuação padrão quando não definida.
+func (c *Championship) Points() PointsSystem {
+	if c.PointsSystem == nil {
+		return DefaultPointsSystem
+	}
+	return *c.PointsSystem
+}
+
+// StandingsTiebreakers devolve, em ordem, os critérios aplicados aos times empatados em pontos.
+func (c *Championship) StandingsTiebreakers() []StandingsCriterion {
+	if len(c.Tiebreakers) == 0 {
+		return DefaultStandingsTiebreakers
+	}
+	return c.Tiebreakers
+}
+
+// Disqualification devolve a regra aplicada às partidas por pontos de um time desclassificado.
+// Sem regra definida, as partidas são anuladas.
+func (c *Championship) Disqualification() DisqualificationRule {
+	if c.DisqualificationRule == "" {
+		return DisqualificationVoid
+	}
+	return c.DisqualificationRule
+}
+
+// Discipline devolve as regras de suspensão do campeonato, ou as regras padrão quando não
+// definidas.
+func (c *Championship) Discipline() DisciplinaryRules {
+	if c.DisciplinaryRules == nil {
+		return DefaultDisciplinaryRules
+	}
+	return *c.DisciplinaryRules
+}
+
+// Squad devolve as regras de escalação do campeonato, ou as regras padrão quando não definidas.
+func (c *Championship) Squad() SquadRules {
+	if c.SquadRules == nil {
+		return DefaultSquadRules
+	}
+	return *c.SquadRules
+}
+
+// NumLegs devolve quantas vezes cada par de times se enfrenta nos pontos corridos.
+// Campeonatos sem o valor definido são de turno único.
+func (c *Championship) NumLegs() int {
+	if c.Legs < 1 {
+		return 1
+	}
+	return c.Legs
+}
+
+// IsTwoLegged indica se os confrontos da fase do mata-mata são disputados em ida e volta.
+// Sem fases selecionadas, todas as fases são de ida e volta.
+func (c *Championship) IsTwoLegged(phase int) bool {
+	if !c.TwoLeggedTies {
+		return false
+	}
+	if len(c.TwoLeggedPhases) == 0 {
+		return true
+	}
+	for _, p := range c.TwoLeggedPhases {
+		if p == phase {
+			return true
+		}
+	}
+	return false
+}
+
+// NumSwissRounds devolve o número de rodadas do sistema suíço. Sem valor definido são
+// log2(numTeams) rodadas, arredondado para cima, limitado ao máximo sem repetir confrontos.
+func (c *Championship) NumSwissRounds(numTeams int) int {
+	maxRounds := numTeams - 1
+	if numTeams%2 == 1 {
+		maxRounds = numTeams // Cada time folga uma vez
+	}
+
+	rounds := c.SwissRounds
+	if rounds < 1 {
+		rounds = int(math.Ceil(math.Log2(float64(numTeams))))
+	}
+	if rounds > maxRounds {
+		rounds = maxRounds
+	}
+	return rounds
+}
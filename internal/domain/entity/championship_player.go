@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// ChampionshipPlayer registra um jogador na lista de inscritos de um time em um campeonato.
+// Só os jogadores inscritos podem aparecer nos lances das partidas do time.
+type ChampionshipPlayer struct {
+	ChampionshipID uuid.UUID `json:"championship_id" validate:"required"`
+	TeamID         uuid.UUID `json:"team_id" validate:"required"`
+	PlayerID       uuid.UUID `json:"player_id" validate:"required"`
+	RegisteredAt   time.Time `json:"registered_at" validate:"required"`
+}
+
+func (cp *ChampionshipPlayer) Validate() error {
+	validate := validator.New()
+	return validate.Struct(cp)
+}
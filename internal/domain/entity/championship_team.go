@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// ChampionshipTeam registra a inscrição de um time em um campeonato.
+type ChampionshipTeam struct {
+	ChampionshipID uuid.UUID `json:"championship_id" validate:"required"`
+	TeamID         uuid.UUID `json:"team_id" validate:"required"`
+	EnrolledAt     time.Time `json:"enrolled_at" validate:"required"`
+}
+
+func (ct *ChampionshipTeam) Validate() error {
+	validate := validator.New()
+	return validate.Struct(ct)
+}
@@ -171,6 +171,82 @@ func TestChampionshipValidation_InvalidType(t *testing.T) {
 	assert.Equal(t, "oneof", validationErrors[0].Tag())
 }
 
+func TestChampionshipValidation_GroupsKnockout(t *testing.T) {
+	championship := &Championship{
+		ID:                 uuid.New(),
+		Name:               "Copa do Mundo",
+		Type:               ChampionshipTypeGroupsKnockout,
+		TiebreakerMethod:   TiebreakerPenalties,
+		ProgressionType:    ProgressionFixed,
+		NumGroups:          8,
+		QualifiersPerGroup: 2,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+
+	err := championship.Validate()
+	assert.NoError(t, err)
+}
+
+func TestChampionshipValidation_NegativeNumGroups(t *testing.T) {
+	championship := &Championship{
+		ID:               uuid.New(),
+		Name:             "Copa do Mundo",
+		Type:             ChampionshipTypeGroupsKnockout,
+		TiebreakerMethod: TiebreakerPenalties,
+		ProgressionType:  ProgressionFixed,
+		NumGroups:        -1, // Valor inválido
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+
+	err := championship.Validate()
+	assert.Error(t, err)
+	validationErrors := err.(validator.ValidationErrors)
+	assert.Equal(t, "NumGroups", validationErrors[0].Field())
+	assert.Equal(t, "gte", validationErrors[0].Tag())
+}
+
+func TestChampionshipValidation_InvalidLegs(t *testing.T) {
+	championship := &Championship{
+		ID:               uuid.New(),
+		Name:             "Campeonato Brasileiro",
+		Type:             ChampionshipTypeLeague,
+		TiebreakerMethod: TiebreakerPenalties,
+		ProgressionType:  ProgressionFixed,
+		Legs:             3, // Apenas 1, 2 ou 4 turnos
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+
+	err := championship.Validate()
+	assert.Error(t, err)
+	validationErrors := err.(validator.ValidationErrors)
+	assert.Equal(t, "Legs", validationErrors[0].Field())
+	assert.Equal(t, "oneof", validationErrors[0].Tag())
+}
+
+func TestChampionship_NumLegs(t *testing.T) {
+	championship := &Championship{}
+	assert.Equal(t, 1, championship.NumLegs(), "Sem valor definido o campeonato é de turno único")
+
+	championship.Legs = 2
+	assert.Equal(t, 2, championship.NumLegs())
+}
+
+func TestChampionship_NumSwissRounds(t *testing.T) {
+	championship := &Championship{Type: ChampionshipTypeSwiss}
+
+	// Sem valor definido: log2 do número de times, arredondado para cima
+	assert.Equal(t, 6, championship.NumSwissRounds(40))
+	assert.Equal(t, 3, championship.NumSwissRounds(8))
+
+	// Nunca mais rodadas do que o possível sem repetir confrontos
+	championship.SwissRounds = 10
+	assert.Equal(t, 3, championship.NumSwissRounds(4))
+	assert.Equal(t, 5, championship.NumSwissRounds(5))
+}
+
 func TestChampionshipValidation_InvalidTiebreakerMethod(t *testing.T) {
 	championship := &Championship{
 		ID:               uuid.New(),
@@ -284,3 +360,102 @@ func TestChampionshipValidation_MultipleErrors(t *testing.T) {
 		assert.Equal(t, tag, fieldsWithErrors[field], "Erro no campo %s", field)
 	}
 }
+
+func TestChampionship_IsTwoLegged(t *testing.T) {
+	championship := &Championship{}
+	assert.False(t, championship.IsTwoLegged(1))
+
+	championship.TwoLeggedTies = true
+	assert.True(t, championship.IsTwoLegged(1))
+	assert.True(t, championship.IsTwoLegged(3))
+
+	championship.TwoLeggedPhases = []int{2}
+	assert.False(t, championship.IsTwoLegged(1))
+	assert.True(t, championship.IsTwoLegged(2))
+}
+
+func TestChampionship_CanTransitionTo(t *testing.T) {
+	championship := &Championship{Status: ChampionshipStatusDraft}
+	assert.True(t, championship.CanTransitionTo(ChampionshipStatusRegistration))
+	assert.True(t, championship.CanTransitionTo(ChampionshipStatusInProgress))
+	assert.False(t, championship.CanTransitionTo(ChampionshipStatusFinished))
+	assert.True(t, championship.IsEditable())
+
+	championship.Status = ChampionshipStatusInProgress
+	assert.True(t, championship.CanTransitionTo(ChampionshipStatusFinished))
+	assert.False(t, championship.CanTransitionTo(ChampionshipStatusRegistration))
+	assert.False(t, championship.IsEditable())
+
+	championship.Status = ChampionshipStatusFinished
+	assert.False(t, championship.CanTransitionTo(ChampionshipStatusCancelled))
+}
+
+func TestChampionship_PointsAndTiebreakersDefaults(t *testing.T) {
+	championship := &Championship{}
+	assert.Equal(t, DefaultPointsSystem, championship.Points())
+	assert.Equal(t, DefaultStandingsTiebreakers, championship.StandingsTiebreakers())
+
+	championship.PointsSystem = &PointsSystem{Win: 2}
+	championship.Tiebreakers = []StandingsCriterion{CriterionHeadToHeadPoints, CriterionDrawingOfLots}
+	assert.Equal(t, 2, championship.Points().Win)
+	assert.Equal(t, CriterionHeadToHeadPoints, championship.StandingsTiebreakers()[0])
+}
+
+func TestChampionshipValidation_InvalidTiebreakers(t *testing.T) {
+	championship := &Championship{
+		ID:               uuid.New(),
+		Name:             "Campeonato Brasileiro",
+		Type:             ChampionshipTypeLeague,
+		TiebreakerMethod: TiebreakerPenalties,
+		ProgressionType:  ProgressionFixed,
+		PointsSystem:     &PointsSystem{Win: 3, Draw: 1},
+		Tiebreakers:      []StandingsCriterion{CriterionGoalDifference, "fair_play"},
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+
+	err := championship.Validate()
+	assert.Error(t, err)
+	validationErrors := err.(validator.ValidationErrors)
+	assert.Equal(t, "Tiebreakers[1]", validationErrors[0].Field())
+	assert.Equal(t, "oneof", validationErrors[0].Tag())
+
+	championship.Tiebreakers = nil
+	championship.PointsSystem.Loss = -1
+	assert.Error(t, championship.Validate())
+}
+
+func TestChampionship_Disqualification(t *testing.T) {
+	championship := &Championship{}
+	assert.Equal(t, DisqualificationVoid, championship.Disqualification())
+
+	championship.DisqualificationRule = DisqualificationAward
+	assert.Equal(t, DisqualificationAward, championship.Disqualification())
+}
+
+func TestChampionship_Discipline(t *testing.T) {
+	championship := &Championship{}
+	assert.Equal(t, DefaultDisciplinaryRules, championship.Discipline())
+
+	championship.DisciplinaryRules = &DisciplinaryRules{YellowCards: 2, Matches: 2}
+	assert.Equal(t, 2, championship.Discipline().Matches)
+
+	championship.DisciplinaryRules.Matches = 0
+	err := validator.New().Struct(championship.DisciplinaryRules)
+	assert.Error(t, err)
+}
+
+func TestChampionship_Squad(t *testing.T) {
+	championship := &Championship{}
+	assert.Equal(t, DefaultSquadRules, championship.Squad())
+
+	championship.SquadRules = &SquadRules{Starters: 7, MinStarters: 5, Substitutes: 5}
+	assert.Equal(t, 7, championship.Squad().Starters)
+
+	// O mínimo de titulares não pode passar do máximo
+	championship.SquadRules.MinStarters = 8
+	err := validator.New().Struct(championship.SquadRules)
+	assert.Error(t, err)
+	validationErrors := err.(validator.ValidationErrors)
+	assert.Equal(t, "MinStarters", validationErrors[0].Field())
+}
@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// CupStatistics acumula os números de um time no mata-mata do campeonato. Os gols incluem a
+// prorrogação; as disputas de pênaltis contam à parte. FurthestPhase é a fase mais adiantada
+// em que o time entrou na chave, e Eliminated indica que ele já caiu.
+type CupStatistics struct {
+	ID              uuid.UUID `json:"id" validate:"required"`
+	ChampionshipID  uuid.UUID `json:"championship_id" validate:"required"`
+	TeamID          uuid.UUID `json:"team_id" validate:"required"`
+	MatchesPlayed   int       `json:"matches_played" validate:"gte=0"`
+	Wins            int       `json:"wins" validate:"gte=0"`
+	Draws           int       `json:"draws" validate:"gte=0"`
+	Losses          int       `json:"losses" validate:"gte=0"`
+	GoalsFor        int       `json:"goals_for" validate:"gte=0"`
+	GoalsAgainst    int       `json:"goals_against" validate:"gte=0"`
+	ExtraTimeWins   int       `json:"extra_time_wins" validate:"gte=0"`
+	ExtraTimeLosses int       `json:"extra_time_losses" validate:"gte=0"`
+	ShootoutWins    int       `json:"shootout_wins" validate:"gte=0"`
+	ShootoutLosses  int       `json:"shootout_losses" validate:"gte=0"`
+	FurthestPhase   int       `json:"furthest_phase" validate:"gte=0"`
+	Eliminated      bool      `json:"eliminated"`
+	CreatedAt       time.Time `json:"created_at" validate:"required"`
+	UpdatedAt       time.Time `json:"updated_at" validate:"required"`
+}
+
+func (cs *CupStatistics) Validate() error {
+	validate := validator.New()
+	return validate.Struct(cs)
+}
+
+// GoalDifference é o saldo de gols do time no mata-mata.
+func (cs *CupStatistics) GoalDifference() int {
+	return cs.GoalsFor - cs.GoalsAgainst
+}
+
+type BracketResult string
+
+const (
+	BracketResultWon     BracketResult = "won"
+	BracketResultLost    BracketResult = "lost"
+	BracketResultDrawn   BracketResult = "drawn" // Jogo de ida empatado; o confronto segue na volta
+	BracketResultBye     BracketResult = "bye"
+	BracketResultPending BracketResult = "pending"
+)
+
+// BracketStep é uma partida do time no mata-mata, vista do lado dele: placar a favor e contra,
+// incluindo a prorrogação, e os pênaltis quando houve disputa.
+type BracketStep struct {
+	MatchID          uuid.UUID     `json:"match_id"`
+	Phase            int           `json:"phase"`
+	Bracket          MatchBracket  `json:"bracket,omitempty"`
+	Leg              int           `json:"leg,omitempty"`
+	MatchDate        *time.Time    `json:"match_date,omitempty"`
+	Status           MatchStatus   `json:"status"`
+	Home             bool          `json:"home"`
+	OpponentID       *uuid.UUID    `json:"opponent_id,omitempty"`
+	GoalsFor         int           `json:"goals_for"`
+	GoalsAgainst     int           `json:"goals_against"`
+	ExtraTime        bool          `json:"extra_time"`
+	Penalties        bool          `json:"penalties"`
+	PenaltiesFor     int           `json:"penalties_for"`
+	PenaltiesAgainst int           `json:"penalties_against"`
+	Walkover         bool          `json:"walkover"`
+	Result           BracketResult `json:"result"`
+}
+
+// BracketPath é o caminho de um time pela chave do mata-mata, na ordem das fases.
+type BracketPath struct {
+	ChampionshipID uuid.UUID      `json:"championship_id"`
+	TeamID         uuid.UUID      `json:"team_id"`
+	FurthestPhase  int            `json:"furthest_phase"`
+	Eliminated     bool           `json:"eliminated"`
+	Steps          []*BracketStep `json:"steps"`
+}
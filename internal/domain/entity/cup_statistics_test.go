@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCupStatisticsValidation_NegativeWins(t *testing.T) {
+	stats := &CupStatistics{
+		ID:             uuid.New(),
+		ChampionshipID: uuid.New(),
+		TeamID:         uuid.New(),
+		Wins:           -1,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	err := stats.Validate()
+	assert.Error(t, err)
+	validationErrors := err.(validator.ValidationErrors)
+	assert.Equal(t, "Wins", validationErrors[0].Field())
+	assert.Equal(t, "gte", validationErrors[0].Tag())
+
+	stats.Wins = 1
+	assert.NoError(t, stats.Validate())
+}
+
+func TestCupStatistics_GoalDifference(t *testing.T) {
+	stats := &CupStatistics{GoalsFor: 2, GoalsAgainst: 5}
+	assert.Equal(t, -3, stats.GoalDifference())
+}
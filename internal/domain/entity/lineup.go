@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// LineupPlayer é um jogador relacionado para a partida, como titular ou reserva. Nome, número e
+// posição vêm do elenco do time.
+type LineupPlayer struct {
+	PlayerID    uuid.UUID      `json:"player_id" validate:"required"`
+	Name        string         `json:"name"`
+	ShirtNumber int            `json:"shirt_number"`
+	Position    PlayerPosition `json:"position"`
+	Starter     bool           `json:"starter"`
+}
+
+// Lineup é a escalação de um time para uma partida: os titulares e os reservas relacionados
+// na súmula. A escalação pode ser reenviada até o início da partida.
+type Lineup struct {
+	MatchID     uuid.UUID       `json:"match_id" validate:"required"`
+	TeamID      uuid.UUID       `json:"team_id" validate:"required"`
+	Players     []*LineupPlayer `json:"players" validate:"required,min=1,dive"`
+	SubmittedAt time.Time       `json:"submitted_at" validate:"required"`
+}
+
+func (l *Lineup) Validate() error {
+	validate := validator.New()
+	return validate.Struct(l)
+}
+
+// Starters devolve os titulares, na ordem em que foram relacionados.
+func (l *Lineup) Starters() []*LineupPlayer {
+	return l.filter(true)
+}
+
+// Substitutes devolve os reservas, na ordem em que foram relacionados.
+func (l *Lineup) Substitutes() []*LineupPlayer {
+	return l.filter(false)
+}
+
+func (l *Lineup) filter(starter bool) []*LineupPlayer {
+	var players []*LineupPlayer
+	for _, player := range l.Players {
+		if player.Starter == starter {
+			players = append(players, player)
+		}
+	}
+	return players
+}
+
+// Includes indica se o jogador foi relacionado para a partida.
+func (l *Lineup) Includes(playerID uuid.UUID) bool {
+	for _, player := range l.Players {
+		if player.PlayerID == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchSheet reúne o necessário para a súmula impressa da partida: o campeonato, os times e as
+// escalações enviadas. Escalações ainda não enviadas ficam nulas.
+type MatchSheet struct {
+	Championship *Championship `json:"championship"`
+	Match        *Match        `json:"match"`
+	HomeTeam     *Team         `json:"home_team"`
+	AwayTeam     *Team         `json:"away_team"`
+	HomeLineup   *Lineup       `json:"home_lineup,omitempty"`
+	AwayLineup   *Lineup       `json:"away_lineup,omitempty"`
+}
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLineupValidation_NoPlayers(t *testing.T) {
+	lineup := &Lineup{
+		MatchID:     uuid.New(),
+		TeamID:      uuid.New(),
+		SubmittedAt: time.Now(),
+	}
+
+	err := lineup.Validate()
+	assert.Error(t, err)
+	validationErrors := err.(validator.ValidationErrors)
+	assert.Equal(t, "Players", validationErrors[0].Field())
+	assert.Equal(t, "required", validationErrors[0].Tag())
+}
+
+func TestLineup_StartersAndSubstitutes(t *testing.T) {
+	keeper, striker, substitute := uuid.New(), uuid.New(), uuid.New()
+	lineup := &Lineup{
+		Players: []*LineupPlayer{
+			{PlayerID: keeper, Starter: true},
+			{PlayerID: substitute},
+			{PlayerID: striker, Starter: true},
+		},
+	}
+
+	starters := lineup.Starters()
+	assert.Len(t, starters, 2)
+	assert.Equal(t, keeper, starters[0].PlayerID)
+	assert.Equal(t, striker, starters[1].PlayerID)
+	assert.Len(t, lineup.Substitutes(), 1)
+
+	assert.True(t, lineup.Includes(substitute))
+	assert.False(t, lineup.Includes(uuid.New()))
+}
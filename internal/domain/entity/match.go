@@ -8,38 +8,88 @@ import (
 )
 
 type MatchStatus string
+type MatchBracket string
 
 const (
 	MatchStatusScheduled  MatchStatus = "scheduled"
 	MatchStatusInProgress MatchStatus = "in_progress"
 	MatchStatusFinished   MatchStatus = "finished"
+	MatchStatusBye        MatchStatus = "bye"
+	MatchStatusPostponed  MatchStatus = "postponed"
+	MatchStatusAbandoned  MatchStatus = "abandoned"
+	MatchStatusCancelled  MatchStatus = "cancelled" // Anulada pela desclassificação de um dos times
+
+	MatchBracketWinners    MatchBracket = "winners"
+	MatchBracketLosers     MatchBracket = "losers"
+	MatchBracketGrandFinal MatchBracket = "grand_final"
+	MatchBracketReset      MatchBracket = "bracket_reset"
+	MatchBracketThirdPlace MatchBracket = "third_place"
 )
 
+// matchTransitions lista, para cada status, os status que podem vir em seguida. O resultado
+// pode ser lançado direto de uma partida agendada; partidas encerradas só mudam por correção.
+// Partidas só são canceladas pela desclassificação de um dos times.
+var matchTransitions = map[MatchStatus][]MatchStatus{
+	MatchStatusScheduled:  {MatchStatusInProgress, MatchStatusFinished, MatchStatusPostponed},
+	MatchStatusPostponed:  {MatchStatusInProgress, MatchStatusFinished, MatchStatusPostponed},
+	MatchStatusInProgress: {MatchStatusFinished, MatchStatusAbandoned},
+	MatchStatusAbandoned:  {MatchStatusFinished, MatchStatusPostponed},
+}
+
 type Match struct {
-	ID                 uuid.UUID   `json:"id" validate:"required"`
-	ChampionshipID     uuid.UUID   `json:"championship_id" validate:"required"`
-	HomeTeamID         *uuid.UUID  `json:"home_team_id,omitempty" validate:"omitempty"`
-	AwayTeamID         *uuid.UUID  `json:"away_team_id,omitempty" validate:"omitempty"`
-	MatchDate          *time.Time  `json:"match_date,omitempty" validate:"omitempty"`
-	Status             MatchStatus `json:"status" validate:"required,oneof=scheduled in_progress finished"`
-	ScoreHome          int         `json:"score_home" validate:"gte=0"`
-	ScoreAway          int         `json:"score_away" validate:"gte=0"`
-	HasExtraTime       bool        `json:"has_extra_time"`
-	ScoreHomeExtraTime int         `json:"score_home_extra_time" validate:"gte=0"`
-	ScoreAwayExtraTime int         `json:"score_away_extra_time" validate:"gte=0"`
-	HasPenalties       bool        `json:"has_penalties"`
-	ScoreHomePenalties int         `json:"score_home_penalties" validate:"gte=0"`
-	ScoreAwayPenalties int         `json:"score_away_penalties" validate:"gte=0"`
-	WinnerTeamID       *uuid.UUID  `json:"winner_team_id,omitempty" validate:"omitempty"`
-	Phase              int         `json:"phase" validate:"required,gte=1"`
-	ParentMatchID      *uuid.UUID  `json:"parent_match_id,omitempty" validate:"omitempty"`
-	LeftChildMatchID   *uuid.UUID  `json:"left_child_match_id,omitempty" validate:"omitempty"`
-	RightChildMatchID  *uuid.UUID  `json:"right_child_match_id,omitempty" validate:"omitempty"`
-	CreatedAt          time.Time   `json:"created_at" validate:"required"`
-	UpdatedAt          time.Time   `json:"updated_at" validate:"required"`
+	ID                 uuid.UUID    `json:"id" validate:"required"`
+	ChampionshipID     uuid.UUID    `json:"championship_id" validate:"required"`
+	HomeTeamID         *uuid.UUID   `json:"home_team_id,omitempty" validate:"omitempty"`
+	AwayTeamID         *uuid.UUID   `json:"away_team_id,omitempty" validate:"omitempty"`
+	MatchDate          *time.Time   `json:"match_date,omitempty" validate:"omitempty"`
+	Status             MatchStatus  `json:"status" validate:"required,oneof=scheduled in_progress finished bye postponed abandoned cancelled"`
+	ScoreHome          int          `json:"score_home" validate:"gte=0"`
+	ScoreAway          int          `json:"score_away" validate:"gte=0"`
+	HasExtraTime       bool         `json:"has_extra_time"`
+	ScoreHomeExtraTime int          `json:"score_home_extra_time" validate:"gte=0"`
+	ScoreAwayExtraTime int          `json:"score_away_extra_time" validate:"gte=0"`
+	HasPenalties       bool         `json:"has_penalties"`
+	ScoreHomePenalties int          `json:"score_home_penalties" validate:"gte=0"`
+	ScoreAwayPenalties int          `json:"score_away_penalties" validate:"gte=0"`
+	WinnerTeamID       *uuid.UUID   `json:"winner_team_id,omitempty" validate:"omitempty"`
+	Walkover           bool         `json:"walkover"`
+	Phase              int          `json:"phase" validate:"required,gte=1"`
+	Round              int          `json:"round,omitempty" validate:"gte=0"`
+	GroupNumber        *int         `json:"group_number,omitempty" validate:"omitempty,gte=1"`
+	Bracket            MatchBracket `json:"bracket,omitempty" validate:"omitempty,oneof=winners losers grand_final bracket_reset third_place"`
+	ParentMatchID      *uuid.UUID   `json:"parent_match_id,omitempty" validate:"omitempty"`
+	LeftChildMatchID   *uuid.UUID   `json:"left_child_match_id,omitempty" validate:"omitempty"`
+	RightChildMatchID  *uuid.UUID   `json:"right_child_match_id,omitempty" validate:"omitempty"`
+	Leg                int          `json:"leg,omitempty" validate:"gte=0,lte=2"`
+	FirstLegMatchID    *uuid.UUID   `json:"first_leg_match_id,omitempty" validate:"omitempty"`
+	LoserMatchID       *uuid.UUID   `json:"loser_match_id,omitempty" validate:"omitempty"`
+	CreatedAt          time.Time    `json:"created_at" validate:"required"`
+	UpdatedAt          time.Time    `json:"updated_at" validate:"required"`
 }
 
 func (m *Match) Validate() error {
 	validate := validator.New()
 	return validate.Struct(m)
 }
+
+// IsCompleted indica se a partida já tem desfecho, seja por resultado em campo, por bye ou
+// por ter sido cancelada.
+func (m *Match) IsCompleted() bool {
+	return m.Status == MatchStatusFinished || m.Status == MatchStatusBye || m.Status == MatchStatusCancelled
+}
+
+// IsAwaitingWalkover indica se a partida ainda espera o adversário de um time desclassificado,
+// que avança por W.O. assim que for definido.
+func (m *Match) IsAwaitingWalkover() bool {
+	return m.Walkover && !m.IsCompleted()
+}
+
+// CanTransitionTo indica se a partida pode passar do status atual para o informado.
+func (m *Match) CanTransitionTo(status MatchStatus) bool {
+	for _, next := range matchTransitions[m.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type MatchEventType string
+
+const (
+	MatchEventGoal         MatchEventType = "goal"
+	MatchEventOwnGoal      MatchEventType = "own_goal"
+	MatchEventYellowCard   MatchEventType = "yellow_card"
+	MatchEventRedCard      MatchEventType = "red_card"
+	MatchEventSubstitution MatchEventType = "substitution"
+)
+
+// MatchEvent registra um lance da partida. TeamID é o time do jogador envolvido; em um gol
+// contra, o gol conta para o adversário. Na substituição, PlayerName é o jogador que sai e
+// SubstitutePlayerName o que entra. Os IDs apontam para os jogadores inscritos no campeonato;
+// quando informados, os nomes são preenchidos a partir do elenco.
+type MatchEvent struct {
+	ID                   uuid.UUID      `json:"id" validate:"required"`
+	MatchID              uuid.UUID      `json:"match_id" validate:"required"`
+	TeamID               uuid.UUID      `json:"team_id" validate:"required"`
+	Type                 MatchEventType `json:"type" validate:"required,oneof=goal own_goal yellow_card red_card substitution"`
+	Minute               int            `json:"minute" validate:"gte=0,lte=150"`
+	ExtraTime            bool           `json:"extra_time"`
+	PlayerID             *uuid.UUID     `json:"player_id,omitempty"`
+	PlayerName           string         `json:"player_name" validate:"required,max=100"`
+	AssistPlayerID       *uuid.UUID     `json:"assist_player_id,omitempty"`
+	SubstitutePlayerID   *uuid.UUID     `json:"substitute_player_id,omitempty"`
+	SubstitutePlayerName string         `json:"substitute_player_name,omitempty" validate:"required_if=Type substitution,max=100"`
+	CreatedAt            time.Time      `json:"created_at" validate:"required"`
+}
+
+func (e *MatchEvent) Validate() error {
+	validate := validator.New()
+	return validate.Struct(e)
+}
+
+// IsGoal indica se o evento altera o placar.
+func (e *MatchEvent) IsGoal() bool {
+	return e.Type == MatchEventGoal || e.Type == MatchEventOwnGoal
+}
+
+// ScoresForHome indica se o gol conta para o time da casa da partida.
+func (e *MatchEvent) ScoresForHome(match *Match) bool {
+	isHome := match.HomeTeamID != nil && *match.HomeTeamID == e.TeamID
+	if e.Type == MatchEventOwnGoal {
+		return !isHome
+	}
+	return isHome
+}
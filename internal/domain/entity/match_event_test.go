@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchEventValidation_Success(t *testing.T) {
+	event := &MatchEvent{
+		ID:         uuid.New(),
+		MatchID:    uuid.New(),
+		TeamID:     uuid.New(),
+		Type:       MatchEventGoal,
+		Minute:     23,
+		PlayerName: "Sócrates",
+		CreatedAt:  time.Now(),
+	}
+
+	err := event.Validate()
+	assert.NoError(t, err)
+}
+
+func TestMatchEventValidation_SubstitutionWithoutSubstitute(t *testing.T) {
+	event := &MatchEvent{
+		ID:         uuid.New(),
+		MatchID:    uuid.New(),
+		TeamID:     uuid.New(),
+		Type:       MatchEventSubstitution,
+		Minute:     60,
+		PlayerName: "Sócrates",
+		CreatedAt:  time.Now(),
+	}
+
+	err := event.Validate()
+	assert.Error(t, err)
+	validationErrors := err.(validator.ValidationErrors)
+	assert.Equal(t, "SubstitutePlayerName", validationErrors[0].Field())
+	assert.Equal(t, "required_if", validationErrors[0].Tag())
+
+	event.SubstitutePlayerName = "Casagrande"
+	assert.NoError(t, event.Validate())
+}
+
+func TestMatchEventValidation_InvalidMinute(t *testing.T) {
+	event := &MatchEvent{
+		ID:         uuid.New(),
+		MatchID:    uuid.New(),
+		TeamID:     uuid.New(),
+		Type:       MatchEventYellowCard,
+		Minute:     -1,
+		PlayerName: "Sócrates",
+		CreatedAt:  time.Now(),
+	}
+
+	err := event.Validate()
+	assert.Error(t, err)
+	validationErrors := err.(validator.ValidationErrors)
+	assert.Equal(t, "Minute", validationErrors[0].Field())
+	assert.Equal(t, "gte", validationErrors[0].Tag())
+}
+
+func TestMatchEvent_ScoresForHome(t *testing.T) {
+	home, away := uuid.New(), uuid.New()
+	match := &Match{HomeTeamID: &home, AwayTeamID: &away}
+
+	goal := &MatchEvent{TeamID: home, Type: MatchEventGoal}
+	assert.True(t, goal.IsGoal())
+	assert.True(t, goal.ScoresForHome(match))
+
+	// Gol contra do time da casa conta para o visitante
+	ownGoal := &MatchEvent{TeamID: home, Type: MatchEventOwnGoal}
+	assert.True(t, ownGoal.IsGoal())
+	assert.False(t, ownGoal.ScoresForHome(match))
+
+	ownGoal.TeamID = away
+	assert.True(t, ownGoal.ScoresForHome(match))
+
+	card := &MatchEvent{TeamID: home, Type: MatchEventRedCard}
+	assert.False(t, card.IsGoal())
+}
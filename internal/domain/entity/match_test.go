@@ -293,3 +293,55 @@ func TestMatchValidation_WithParentAndChildMatches(t *testing.T) {
 	err := match.Validate()
 	assert.NoError(t, err)
 }
+
+func TestMatch_IsCompleted(t *testing.T) {
+	match := &Match{Status: MatchStatusScheduled}
+	assert.False(t, match.IsCompleted())
+
+	match.Status = MatchStatusInProgress
+	assert.False(t, match.IsCompleted())
+
+	match.Status = MatchStatusFinished
+	assert.True(t, match.IsCompleted())
+
+	match.Status = MatchStatusBye
+	assert.True(t, match.IsCompleted())
+
+	match.Status = MatchStatusCancelled
+	assert.True(t, match.IsCompleted())
+}
+
+func TestMatch_IsAwaitingWalkover(t *testing.T) {
+	match := &Match{Status: MatchStatusScheduled}
+	assert.False(t, match.IsAwaitingWalkover())
+
+	match.Walkover = true
+	assert.True(t, match.IsAwaitingWalkover())
+
+	match.Status = MatchStatusFinished
+	assert.False(t, match.IsAwaitingWalkover())
+}
+
+func TestMatch_CanTransitionTo(t *testing.T) {
+	match := &Match{Status: MatchStatusScheduled}
+	assert.True(t, match.CanTransitionTo(MatchStatusInProgress))
+	assert.True(t, match.CanTransitionTo(MatchStatusPostponed))
+	assert.False(t, match.CanTransitionTo(MatchStatusAbandoned))
+
+	match.Status = MatchStatusInProgress
+	assert.True(t, match.CanTransitionTo(MatchStatusFinished))
+	assert.True(t, match.CanTransitionTo(MatchStatusAbandoned))
+	assert.False(t, match.CanTransitionTo(MatchStatusPostponed))
+
+	match.Status = MatchStatusAbandoned
+	assert.True(t, match.CanTransitionTo(MatchStatusPostponed))
+	assert.True(t, match.CanTransitionTo(MatchStatusFinished))
+	assert.False(t, match.CanTransitionTo(MatchStatusInProgress))
+
+	match.Status = MatchStatusFinished
+	assert.False(t, match.CanTransitionTo(MatchStatusInProgress))
+	assert.False(t, match.CanTransitionTo(MatchStatusPostponed))
+
+	match.Status = MatchStatusBye
+	assert.False(t, match.CanTransitionTo(MatchStatusFinished))
+}
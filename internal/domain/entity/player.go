@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type PlayerPosition string
+
+const (
+	PlayerPositionGoalkeeper PlayerPosition = "goalkeeper"
+	PlayerPositionDefender   PlayerPosition = "defender"
+	PlayerPositionMidfielder PlayerPosition = "midfielder"
+	PlayerPositionForward    PlayerPosition = "forward"
+)
+
+// Player é um jogador do elenco de um time. JoinedAt e LeftAt delimitam o período em que o
+// jogador esteve registrado no time; LeftAt nulo indica que ele continua no elenco. Um jogador
+// transferido ganha um novo registro no elenco do novo time.
+type Player struct {
+	ID          uuid.UUID      `json:"id" validate:"required"`
+	TeamID      uuid.UUID      `json:"team_id" validate:"required"`
+	Name        string         `json:"name" validate:"required,min=2,max=100"`
+	ShirtNumber int            `json:"shirt_number" validate:"gte=1,lte=99"`
+	Position    PlayerPosition `json:"position" validate:"required,oneof=goalkeeper defender midfielder forward"`
+	DateOfBirth *time.Time     `json:"date_of_birth,omitempty"`
+	JoinedAt    time.Time      `json:"joined_at" validate:"required"`
+	LeftAt      *time.Time     `json:"left_at,omitempty" validate:"omitempty,gtfield=JoinedAt"`
+	CreatedAt   time.Time      `json:"created_at" validate:"required"`
+	UpdatedAt   time.Time      `json:"updated_at" validate:"required"`
+}
+
+func (p *Player) Validate() error {
+	validate := validator.New()
+	return validate.Struct(p)
+}
+
+// IsRegisteredAt indica se o jogador estava no elenco do time no momento informado.
+func (p *Player) IsRegisteredAt(t time.Time) bool {
+	if t.Before(p.JoinedAt) {
+		return false
+	}
+	return p.LeftAt == nil || t.Before(*p.LeftAt)
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// PlayerStatisticsOrder é o critério de ordenação das tabelas de jogadores do campeonato.
+type PlayerStatisticsOrder string
+
+const (
+	PlayerStatisticsByGoals       PlayerStatisticsOrder = "goals"
+	PlayerStatisticsByAssists     PlayerStatisticsOrder = "assists"
+	PlayerStatisticsByCards       PlayerStatisticsOrder = "cards"
+	PlayerStatisticsByMinutes     PlayerStatisticsOrder = "minutes"
+	PlayerStatisticsByCleanSheets PlayerStatisticsOrder = "clean_sheets"
+)
+
+// PlayerStatistics acumula os números de um jogador em um campeonato. CleanSheets conta as
+// partidas em que o jogador atuou como goleiro sem sofrer gols.
+type PlayerStatistics struct {
+	ID             uuid.UUID `json:"id" validate:"required"`
+	ChampionshipID uuid.UUID `json:"championship_id" validate:"required"`
+	PlayerID       uuid.UUID `json:"player_id" validate:"required"`
+	TeamID         uuid.UUID `json:"team_id" validate:"required"`
+	PlayerName     string    `json:"player_name"`
+	MatchesPlayed  int       `json:"matches_played" validate:"gte=0"`
+	MinutesPlayed  int       `json:"minutes_played" validate:"gte=0"`
+	Goals          int       `json:"goals" validate:"gte=0"`
+	Assists        int       `json:"assists" validate:"gte=0"`
+	YellowCards    int       `json:"yellow_cards" validate:"gte=0"`
+	RedCards       int       `json:"red_cards" validate:"gte=0"`
+	CleanSheets    int       `json:"clean_sheets" validate:"gte=0"`
+	CreatedAt      time.Time `json:"created_at" validate:"required"`
+	UpdatedAt      time.Time `json:"updated_at" validate:"required"`
+}
+
+func (ps *PlayerStatistics) Validate() error {
+	validate := validator.New()
+	return validate.Struct(ps)
+}
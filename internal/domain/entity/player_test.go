@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlayerValidation_Success(t *testing.T) {
+	now := time.Now()
+	birth := time.Date(1954, time.February, 19, 0, 0, 0, 0, time.UTC)
+
+	player := &Player{
+		ID:          uuid.New(),
+		TeamID:      uuid.New(),
+		Name:        "Sócrates",
+		ShirtNumber: 8,
+		Position:    PlayerPositionMidfielder,
+		DateOfBirth: &birth,
+		JoinedAt:    now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	err := player.Validate()
+	assert.NoError(t, err)
+}
+
+func TestPlayerValidation_InvalidShirtNumber(t *testing.T) {
+	now := time.Now()
+
+	player := &Player{
+		ID:          uuid.New(),
+		TeamID:      uuid.New(),
+		Name:        "Sócrates",
+		ShirtNumber: 100,
+		Position:    PlayerPositionMidfielder,
+		JoinedAt:    now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	err := player.Validate()
+	assert.Error(t, err)
+	validationErrors := err.(validator.ValidationErrors)
+	assert.Equal(t, "ShirtNumber", validationErrors[0].Field())
+	assert.Equal(t, "lte", validationErrors[0].Tag())
+}
+
+func TestPlayerValidation_LeftBeforeJoining(t *testing.T) {
+	now := time.Now()
+	left := now.Add(-24 * time.Hour)
+
+	player := &Player{
+		ID:          uuid.New(),
+		TeamID:      uuid.New(),
+		Name:        "Sócrates",
+		ShirtNumber: 8,
+		Position:    PlayerPositionMidfielder,
+		JoinedAt:    now,
+		LeftAt:      &left,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	err := player.Validate()
+	assert.Error(t, err)
+	validationErrors := err.(validator.ValidationErrors)
+	assert.Equal(t, "LeftAt", validationErrors[0].Field())
+	assert.Equal(t, "gtfield", validationErrors[0].Tag())
+}
+
+func TestPlayer_IsRegisteredAt(t *testing.T) {
+	joined := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)
+	player := &Player{JoinedAt: joined}
+
+	assert.False(t, player.IsRegisteredAt(joined.Add(-time.Hour)))
+	assert.True(t, player.IsRegisteredAt(joined))
+	assert.True(t, player.IsRegisteredAt(joined.AddDate(1, 0, 0)))
+
+	// Depois de deixar o time o jogador não está mais no elenco
+	left := joined.AddDate(0, 6, 0)
+	player.LeftAt = &left
+	assert.True(t, player.IsRegisteredAt(left.Add(-time.Hour)))
+	assert.False(t, player.IsRegisteredAt(left))
+}
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// StandingsSnapshot guarda a linha de um time na classificação ao fim de uma rodada, contando
+// apenas as partidas das rodadas até ela. Na fase de grupos a posição é dentro do grupo.
+type StandingsSnapshot struct {
+	ChampionshipID uuid.UUID `json:"championship_id" validate:"required"`
+	Round          int       `json:"round" validate:"gte=1"`
+	TeamID         uuid.UUID `json:"team_id" validate:"required"`
+	GroupNumber    *int      `json:"group_number,omitempty" validate:"omitempty,gte=1"`
+	Position       int       `json:"position" validate:"gte=1"`
+	MatchesPlayed  int       `json:"matches_played" validate:"gte=0"`
+	Wins           int       `json:"wins" validate:"gte=0"`
+	Draws          int       `json:"draws" validate:"gte=0"`
+	Losses         int       `json:"losses" validate:"gte=0"`
+	GoalsFor       int       `json:"goals_for" validate:"gte=0"`
+	GoalsAgainst   int       `json:"goals_against" validate:"gte=0"`
+	GoalDifference int       `json:"goal_difference"`
+	Points         int       `json:"points"`
+	CreatedAt      time.Time `json:"created_at" validate:"required"`
+}
+
+func (ss *StandingsSnapshot) Validate() error {
+	validate := validator.New()
+	return validate.Struct(ss)
+}
+
+// StandingsTable é a classificação do campeonato como estava ao fim de uma rodada.
+type StandingsTable struct {
+	Round     int                  `json:"round"`
+	Standings []*StandingsSnapshot `json:"standings"`
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStandingsSnapshotValidation_InvalidRound(t *testing.T) {
+	snapshot := &StandingsSnapshot{
+		ChampionshipID: uuid.New(),
+		TeamID:         uuid.New(),
+		Round:          0,
+		Position:       1,
+		CreatedAt:      time.Now(),
+	}
+
+	err := snapshot.Validate()
+	assert.Error(t, err)
+	validationErrors := err.(validator.ValidationErrors)
+	assert.Equal(t, "Round", validationErrors[0].Field())
+	assert.Equal(t, "gte", validationErrors[0].Tag())
+
+	snapshot.Round = 1
+	assert.NoError(t, snapshot.Validate())
+}
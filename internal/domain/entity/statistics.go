@@ -11,6 +11,7 @@ type Statistics struct {
 	ID             uuid.UUID `json:"id" validate:"required"`
 	ChampionshipID uuid.UUID `json:"championship_id" validate:"required"`
 	TeamID         uuid.UUID `json:"team_id" validate:"required"`
+	GroupNumber    *int      `json:"group_number,omitempty" validate:"omitempty,gte=1"`
 	MatchesPlayed  int       `json:"matches_played" validate:"gte=0"`
 	Wins           int       `json:"wins" validate:"gte=0"`
 	Draws          int       `json:"draws" validate:"gte=0"`
@@ -19,6 +20,7 @@ type Statistics struct {
 	GoalsAgainst   int       `json:"goals_against" validate:"gte=0"`
 	GoalDifference int       `json:"goal_difference" validate:"gte=0"`
 	Points         int       `json:"points" validate:"gte=0"`
+	Disqualified   bool      `json:"disqualified"`
 	CreatedAt      time.Time `json:"created_at" validate:"required"`
 	UpdatedAt      time.Time `json:"updated_at" validate:"required"`
 }
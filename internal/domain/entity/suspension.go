@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type SuspensionReason string
+
+const (
+	SuspensionYellowCards SuspensionReason = "yellow_cards"
+	SuspensionRedCard     SuspensionReason = "red_card"
+)
+
+// Suspension registra a suspensão de um jogador, aplicada na partida MatchID. ServedMatchIDs
+// lista as partidas do time que o jogador já cumpriu; a suspensão acaba quando elas chegam a
+// Matches.
+type Suspension struct {
+	ID             uuid.UUID        `json:"id" validate:"required"`
+	ChampionshipID uuid.UUID        `json:"championship_id" validate:"required"`
+	PlayerID       uuid.UUID        `json:"player_id" validate:"required"`
+	TeamID         uuid.UUID        `json:"team_id" validate:"required"`
+	MatchID        uuid.UUID        `json:"match_id" validate:"required"`
+	PlayerName     string           `json:"player_name"`
+	Reason         SuspensionReason `json:"reason" validate:"required,oneof=yellow_cards red_card"`
+	Matches        int              `json:"matches" validate:"gte=1"`
+	ServedMatchIDs []uuid.UUID      `json:"served_match_ids"`
+	CreatedAt      time.Time        `json:"created_at" validate:"required"`
+	UpdatedAt      time.Time        `json:"updated_at" validate:"required"`
+}
+
+func (s *Suspension) Validate() error {
+	validate := validator.New()
+	return validate.Struct(s)
+}
+
+// IsActive indica se o jogador ainda tem partidas de suspensão a cumprir.
+func (s *Suspension) IsActive() bool {
+	return len(s.ServedMatchIDs) < s.Matches
+}
+
+// Covers indica se o jogador está suspenso na partida: nas partidas já disputadas, se ela foi
+// uma das cumpridas; nas pendentes, se a suspensão ainda está em andamento.
+func (s *Suspension) Covers(match *Match) bool {
+	if slices.Contains(s.ServedMatchIDs, match.ID) {
+		return true
+	}
+	return !match.IsCompleted() && s.IsActive() && s.MatchID != match.ID
+}
@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuspensionValidation_InvalidReason(t *testing.T) {
+	suspension := &Suspension{
+		ID:             uuid.New(),
+		ChampionshipID: uuid.New(),
+		PlayerID:       uuid.New(),
+		TeamID:         uuid.New(),
+		MatchID:        uuid.New(),
+		Reason:         "fair_play",
+		Matches:        1,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	err := suspension.Validate()
+	assert.Error(t, err)
+	validationErrors := err.(validator.ValidationErrors)
+	assert.Equal(t, "Reason", validationErrors[0].Field())
+	assert.Equal(t, "oneof", validationErrors[0].Tag())
+
+	suspension.Reason = SuspensionRedCard
+	assert.NoError(t, suspension.Validate())
+}
+
+func TestSuspension_Covers(t *testing.T) {
+	sentOff := &Match{ID: uuid.New(), Status: MatchStatusFinished}
+	served := &Match{ID: uuid.New(), Status: MatchStatusFinished}
+	next := &Match{ID: uuid.New(), Status: MatchStatusScheduled}
+
+	suspension := &Suspension{MatchID: sentOff.ID, Reason: SuspensionRedCard, Matches: 2}
+	assert.True(t, suspension.IsActive())
+	assert.False(t, suspension.Covers(sentOff), "A partida da expulsão não conta")
+	assert.True(t, suspension.Covers(next))
+
+	suspension.ServedMatchIDs = []uuid.UUID{served.ID, next.ID}
+	assert.False(t, suspension.IsActive())
+	assert.True(t, suspension.Covers(served))
+	assert.True(t, suspension.Covers(next), "Partidas cumpridas continuam cobertas")
+	assert.False(t, suspension.Covers(&Match{ID: uuid.New(), Status: MatchStatusScheduled}))
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ChampionshipPlayerRepository interface {
+	Register(ctx context.Context, championshipPlayer *entity.ChampionshipPlayer) error
+	Unregister(ctx context.Context, championshipID, playerID uuid.UUID) error
+	GetByChampionshipAndPlayer(ctx context.Context, championshipID, playerID uuid.UUID) (*entity.ChampionshipPlayer, error)
+	ListPlayersByChampionshipAndTeam(ctx context.Context, championshipID, teamID uuid.UUID) ([]*entity.Player, error)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type ChampionshipRepository interface {
@@ -13,4 +14,8 @@ type ChampionshipRepository interface {
 	Update(ctx context.Context, championship *entity.Championship) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context) ([]*entity.Championship, error)
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	GetByIDWithTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*entity.Championship, error)
+	CreateWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error
+	UpdateWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error
 }
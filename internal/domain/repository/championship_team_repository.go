@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ChampionshipTeamRepository interface {
+	Enroll(ctx context.Context, championshipTeam *entity.ChampionshipTeam) error
+	Withdraw(ctx context.Context, championshipID, teamID uuid.UUID) error
+	GetByChampionshipAndTeam(ctx context.Context, championshipID, teamID uuid.UUID) (*entity.ChampionshipTeam, error)
+	ListTeamsByChampionshipID(ctx context.Context, championshipID uuid.UUID) ([]*entity.Team, error)
+	EnrollWithTx(ctx context.Context, tx pgx.Tx, championshipTeam *entity.ChampionshipTeam) error
+}
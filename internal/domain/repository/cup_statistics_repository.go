@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type CupStatisticsRepository interface {
+	ListByChampionship(ctx context.Context, championshipID uuid.UUID) ([]*entity.CupStatistics, error)
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	ListByChampionshipWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) ([]*entity.CupStatistics, error)
+	CreateWithTx(ctx context.Context, tx pgx.Tx, stats *entity.CupStatistics) error
+	DeleteByChampionshipWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) error
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type LineupRepository interface {
+	GetByMatchAndTeam(ctx context.Context, matchID, teamID uuid.UUID) (*entity.Lineup, error)
+	ListByMatch(ctx context.Context, matchID uuid.UUID) ([]*entity.Lineup, error)
+	ListByChampionshipIDWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) ([]*entity.Lineup, error)
+	SaveWithTx(ctx context.Context, tx pgx.Tx, lineup *entity.Lineup) error
+	DeleteWithTx(ctx context.Context, tx pgx.Tx, matchID, teamID uuid.UUID) error
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type MatchEventRepository interface {
+	Create(ctx context.Context, event *entity.MatchEvent) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.MatchEvent, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListByMatchID(ctx context.Context, matchID uuid.UUID) ([]*entity.MatchEvent, error)
+	CreateWithTx(ctx context.Context, tx pgx.Tx, event *entity.MatchEvent) error
+	DeleteWithTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
+	ListByMatchIDWithTx(ctx context.Context, tx pgx.Tx, matchID uuid.UUID) ([]*entity.MatchEvent, error)
+	ListByChampionshipIDWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) ([]*entity.MatchEvent, error)
+}
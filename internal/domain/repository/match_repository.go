@@ -15,8 +15,12 @@ type MatchRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetByChampionshipID(ctx context.Context, championshipID uuid.UUID) ([]*entity.Match, error)
 	GetByPhase(ctx context.Context, championshipID uuid.UUID, phase int) ([]*entity.Match, error)
+	GetByRound(ctx context.Context, championshipID uuid.UUID, round int) ([]*entity.Match, error)
 	BeginTx(ctx context.Context) (pgx.Tx, error)
 	CreateWithTx(ctx context.Context, tx pgx.Tx, match *entity.Match) error
 	GetByIDWithTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*entity.Match, error)
 	UpdateWithTx(ctx context.Context, tx pgx.Tx, match *entity.Match) error
+	GetSecondLegWithTx(ctx context.Context, tx pgx.Tx, firstLegMatchID uuid.UUID) (*entity.Match, error)
+	GetByChampionshipIDWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) ([]*entity.Match, error)
+	DeleteWithTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
 }
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type PlayerRepository interface {
+	Create(ctx context.Context, player *entity.Player) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Player, error)
+	Update(ctx context.Context, player *entity.Player) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListByTeamID(ctx context.Context, teamID uuid.UUID) ([]*entity.Player, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type PlayerStatisticsRepository interface {
+	ListByChampionship(ctx context.Context, championshipID uuid.UUID) ([]*entity.PlayerStatistics, error)
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	CreateWithTx(ctx context.Context, tx pgx.Tx, stats *entity.PlayerStatistics) error
+	DeleteByChampionshipWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) error
+}
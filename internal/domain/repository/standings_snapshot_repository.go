@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type StandingsSnapshotRepository interface {
+	ListByChampionship(ctx context.Context, championshipID uuid.UUID) ([]*entity.StandingsSnapshot, error)
+	ListByChampionshipAndRound(ctx context.Context, championshipID uuid.UUID, round int) ([]*entity.StandingsSnapshot, error)
+	ListByChampionshipAndTeam(ctx context.Context, championshipID, teamID uuid.UUID) ([]*entity.StandingsSnapshot, error)
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	CreateWithTx(ctx context.Context, tx pgx.Tx, snapshot *entity.StandingsSnapshot) error
+	DeleteByChampionshipWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) error
+	DeleteFromRoundWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID, fromRound int) error
+}
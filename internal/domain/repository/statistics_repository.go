@@ -15,8 +15,11 @@ type StatisticsRepository interface {
 	Update(ctx context.Context, stats *entity.Statistics) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	ListByChampionship(ctx context.Context, championshipID uuid.UUID) ([]*entity.Statistics, error)
+	ListByChampionshipAndGroup(ctx context.Context, championshipID uuid.UUID, groupNumber int) ([]*entity.Statistics, error)
 	BeginTx(ctx context.Context) (pgx.Tx, error)
 	CreateWithTx(ctx context.Context, tx pgx.Tx, stats *entity.Statistics) error
+	CreateIfNotExistsWithTx(ctx context.Context, tx pgx.Tx, stats *entity.Statistics) error
 	GetByChampionshipAndTeamWithTx(ctx context.Context, tx pgx.Tx, championshipID, teamID uuid.UUID) (*entity.Statistics, error)
 	UpdateWithTx(ctx context.Context, tx pgx.Tx, stats *entity.Statistics) error
+	ListByChampionshipWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) ([]*entity.Statistics, error)
 }
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type SuspensionRepository interface {
+	ListByChampionship(ctx context.Context, championshipID uuid.UUID) ([]*entity.Suspension, error)
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	CreateWithTx(ctx context.Context, tx pgx.Tx, suspension *entity.Suspension) error
+	DeleteByChampionshipWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) error
+}
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_statistics_championship_group;
+ALTER TABLE statistics DROP COLUMN IF EXISTS group_number;
+ALTER TABLE matches DROP COLUMN IF EXISTS group_number;
+ALTER TABLE championships DROP COLUMN IF EXISTS qualifiers_per_group;
+ALTER TABLE championships DROP COLUMN IF EXISTS num_groups;
+-- O PostgreSQL não permite remover valores de um ENUM; 'groups_knockout' permanece em championship_type
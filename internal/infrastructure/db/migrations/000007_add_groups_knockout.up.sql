@@ -0,0 +1,11 @@
+ALTER TYPE championship_type ADD VALUE IF NOT EXISTS 'groups_knockout';
+
+ALTER TABLE championships
+    ADD COLUMN IF NOT EXISTS num_groups INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS qualifiers_per_group INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS group_number INTEGER NULL;
+
+ALTER TABLE statistics ADD COLUMN IF NOT EXISTS group_number INTEGER NULL;
+
+CREATE INDEX idx_statistics_championship_group ON statistics(championship_id, group_number);
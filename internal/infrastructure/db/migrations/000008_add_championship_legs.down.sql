@@ -0,0 +1 @@
+ALTER TABLE championships DROP COLUMN IF EXISTS legs;
@@ -0,0 +1 @@
+ALTER TABLE championships ADD COLUMN IF NOT EXISTS legs INTEGER NOT NULL DEFAULT 1 CHECK (legs IN (1, 2, 4));
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_matches_championship_round;
+ALTER TABLE matches DROP COLUMN IF EXISTS round;
@@ -0,0 +1,3 @@
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS round INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_matches_championship_round ON matches(championship_id, round);
@@ -0,0 +1,2 @@
+ALTER TABLE championships DROP COLUMN IF EXISTS swiss_rounds;
+-- O PostgreSQL não permite remover valores de um ENUM; 'swiss' permanece em championship_type
@@ -0,0 +1,3 @@
+ALTER TYPE championship_type ADD VALUE IF NOT EXISTS 'swiss';
+
+ALTER TABLE championships ADD COLUMN IF NOT EXISTS swiss_rounds INTEGER NOT NULL DEFAULT 0;
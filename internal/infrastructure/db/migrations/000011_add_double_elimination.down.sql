@@ -0,0 +1,4 @@
+ALTER TABLE matches DROP COLUMN IF EXISTS loser_match_id;
+ALTER TABLE matches DROP COLUMN IF EXISTS bracket;
+ALTER TABLE championships DROP COLUMN IF EXISTS bracket_reset;
+ALTER TABLE championships DROP COLUMN IF EXISTS double_elimination;
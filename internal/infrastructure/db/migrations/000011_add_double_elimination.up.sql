@@ -0,0 +1,8 @@
+ALTER TABLE championships
+    ADD COLUMN IF NOT EXISTS double_elimination BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS bracket_reset BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE matches
+    ADD COLUMN IF NOT EXISTS bracket VARCHAR(20) NOT NULL DEFAULT ''
+        CHECK (bracket IN ('', 'winners', 'losers', 'grand_final', 'bracket_reset')),
+    ADD COLUMN IF NOT EXISTS loser_match_id UUID REFERENCES matches(id) DEFERRABLE INITIALLY DEFERRED;
@@ -0,0 +1,8 @@
+UPDATE matches SET loser_match_id = NULL WHERE loser_match_id IN (SELECT id FROM matches WHERE bracket = 'third_place');
+DELETE FROM matches WHERE bracket = 'third_place';
+
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS matches_bracket_check;
+ALTER TABLE matches ADD CONSTRAINT matches_bracket_check
+    CHECK (bracket IN ('', 'winners', 'losers', 'grand_final', 'bracket_reset'));
+
+ALTER TABLE championships DROP COLUMN IF EXISTS third_place_match;
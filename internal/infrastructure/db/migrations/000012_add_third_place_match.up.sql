@@ -0,0 +1,5 @@
+ALTER TABLE championships ADD COLUMN IF NOT EXISTS third_place_match BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE matches DROP CONSTRAINT IF EXISTS matches_bracket_check;
+ALTER TABLE matches ADD CONSTRAINT matches_bracket_check
+    CHECK (bracket IN ('', 'winners', 'losers', 'grand_final', 'bracket_reset', 'third_place'));
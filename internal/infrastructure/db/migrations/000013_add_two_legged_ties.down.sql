@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_matches_first_leg;
+
+ALTER TABLE matches DROP COLUMN IF EXISTS first_leg_match_id;
+ALTER TABLE matches DROP COLUMN IF EXISTS leg;
+
+ALTER TABLE championships DROP COLUMN IF EXISTS away_goals;
+ALTER TABLE championships DROP COLUMN IF EXISTS two_legged_phases;
+ALTER TABLE championships DROP COLUMN IF EXISTS two_legged_ties;
@@ -0,0 +1,10 @@
+ALTER TABLE championships
+    ADD COLUMN IF NOT EXISTS two_legged_ties BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS two_legged_phases INTEGER[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS away_goals BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE matches
+    ADD COLUMN IF NOT EXISTS leg INTEGER NOT NULL DEFAULT 0 CHECK (leg IN (0, 1, 2)),
+    ADD COLUMN IF NOT EXISTS first_leg_match_id UUID REFERENCES matches(id) DEFERRABLE INITIALLY DEFERRED;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_matches_first_leg ON matches(first_leg_match_id);
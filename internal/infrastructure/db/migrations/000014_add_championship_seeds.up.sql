@@ -0,0 +1 @@
+ALTER TABLE championships ADD COLUMN IF NOT EXISTS seeds UUID[] NOT NULL DEFAULT '{}';
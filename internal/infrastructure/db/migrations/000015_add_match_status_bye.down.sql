@@ -0,0 +1,2 @@
+-- O PostgreSQL não permite remover valores de um ENUM; 'bye' permanece em match_status
+UPDATE matches SET status = 'finished' WHERE status = 'bye';
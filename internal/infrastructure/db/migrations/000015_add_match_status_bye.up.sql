@@ -0,0 +1 @@
+ALTER TYPE match_status ADD VALUE IF NOT EXISTS 'bye';
@@ -0,0 +1 @@
+ALTER TABLE championships ADD COLUMN IF NOT EXISTS draw_seed BIGINT NOT NULL DEFAULT 0;
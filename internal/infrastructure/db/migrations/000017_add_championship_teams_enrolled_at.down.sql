@@ -0,0 +1 @@
+ALTER TABLE championship_teams DROP COLUMN IF EXISTS enrolled_at;
@@ -0,0 +1 @@
+ALTER TABLE championship_teams ADD COLUMN IF NOT EXISTS enrolled_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_championships_status;
+ALTER TABLE championships DROP COLUMN IF EXISTS status;
+DROP TYPE IF EXISTS championship_status;
@@ -0,0 +1,18 @@
+CREATE TYPE championship_status AS ENUM ('draft', 'registration', 'in_progress', 'finished', 'cancelled');
+
+ALTER TABLE championships ADD COLUMN IF NOT EXISTS status championship_status NOT NULL DEFAULT 'draft';
+
+-- Campeonatos existentes já tiveram as partidas geradas na criação
+UPDATE championships c
+SET status = 'in_progress'
+WHERE EXISTS (SELECT 1 FROM matches m WHERE m.championship_id = c.id);
+
+UPDATE championships c
+SET status = 'finished'
+WHERE c.status = 'in_progress'
+  AND NOT EXISTS (
+      SELECT 1 FROM matches m
+      WHERE m.championship_id = c.id AND m.status NOT IN ('finished', 'bye')
+  );
+
+CREATE INDEX IF NOT EXISTS idx_championships_status ON championships(status);
@@ -0,0 +1,2 @@
+ALTER TABLE championships DROP COLUMN IF EXISTS tiebreakers;
+ALTER TABLE championships DROP COLUMN IF EXISTS points_system;
@@ -0,0 +1,3 @@
+-- NULL mantém a pontuação padrão (3 pontos pela vitória, 1 pelo empate)
+ALTER TABLE championships ADD COLUMN IF NOT EXISTS points_system JSONB;
+ALTER TABLE championships ADD COLUMN IF NOT EXISTS tiebreakers TEXT[] NOT NULL DEFAULT '{}';
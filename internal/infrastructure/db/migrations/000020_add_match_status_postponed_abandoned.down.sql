@@ -0,0 +1,2 @@
+-- O PostgreSQL não permite remover valores de um ENUM; 'postponed' e 'abandoned' permanecem em match_status
+UPDATE matches SET status = 'scheduled' WHERE status IN ('postponed', 'abandoned');
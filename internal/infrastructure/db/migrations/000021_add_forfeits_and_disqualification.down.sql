@@ -0,0 +1,6 @@
+ALTER TABLE statistics DROP COLUMN IF EXISTS disqualified;
+ALTER TABLE championships DROP COLUMN IF EXISTS disqualification_rule;
+ALTER TABLE matches DROP COLUMN IF EXISTS walkover;
+
+-- O PostgreSQL não permite remover valores de um ENUM; 'cancelled' permanece em match_status
+UPDATE matches SET status = 'scheduled' WHERE status = 'cancelled';
@@ -0,0 +1,9 @@
+ALTER TYPE match_status ADD VALUE IF NOT EXISTS 'cancelled';
+
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS walkover BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE championships
+    ADD COLUMN IF NOT EXISTS disqualification_rule VARCHAR(20) NOT NULL DEFAULT ''
+        CHECK (disqualification_rule IN ('', 'void', 'award'));
+
+ALTER TABLE statistics ADD COLUMN IF NOT EXISTS disqualified BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS match_events;
+DROP TYPE IF EXISTS match_event_type;
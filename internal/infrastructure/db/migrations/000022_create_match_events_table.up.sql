@@ -0,0 +1,15 @@
+CREATE TYPE match_event_type AS ENUM ('goal', 'own_goal', 'yellow_card', 'red_card', 'substitution');
+
+CREATE TABLE IF NOT EXISTS match_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id),
+    type match_event_type NOT NULL,
+    minute INTEGER NOT NULL CHECK (minute >= 0),
+    extra_time BOOLEAN NOT NULL DEFAULT FALSE,
+    player_name VARCHAR(100) NOT NULL,
+    substitute_player_name VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_match_events_match_id ON match_events(match_id);
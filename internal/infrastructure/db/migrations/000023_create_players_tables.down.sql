@@ -0,0 +1,9 @@
+ALTER TABLE match_events
+    DROP COLUMN IF EXISTS player_id,
+    DROP COLUMN IF EXISTS assist_player_id,
+    DROP COLUMN IF EXISTS substitute_player_id;
+
+DROP TABLE IF EXISTS player_statistics;
+DROP TABLE IF EXISTS championship_players;
+DROP TABLE IF EXISTS players;
+DROP TYPE IF EXISTS player_position;
@@ -0,0 +1,48 @@
+CREATE TYPE player_position AS ENUM ('goalkeeper', 'defender', 'midfielder', 'forward');
+
+CREATE TABLE IF NOT EXISTS players (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    shirt_number INTEGER NOT NULL CHECK (shirt_number BETWEEN 1 AND 99),
+    position player_position NOT NULL,
+    date_of_birth DATE,
+    joined_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    left_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_players_team_id ON players(team_id);
+
+CREATE TABLE IF NOT EXISTS championship_players (
+    championship_id UUID NOT NULL REFERENCES championships(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    registered_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (championship_id, player_id)
+);
+
+CREATE INDEX idx_championship_players_team ON championship_players(championship_id, team_id);
+
+CREATE TABLE IF NOT EXISTS player_statistics (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    championship_id UUID NOT NULL REFERENCES championships(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    matches_played INTEGER NOT NULL DEFAULT 0,
+    minutes_played INTEGER NOT NULL DEFAULT 0,
+    goals INTEGER NOT NULL DEFAULT 0,
+    assists INTEGER NOT NULL DEFAULT 0,
+    yellow_cards INTEGER NOT NULL DEFAULT 0,
+    red_cards INTEGER NOT NULL DEFAULT 0,
+    clean_sheets INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (championship_id, player_id)
+);
+
+ALTER TABLE match_events
+    ADD COLUMN IF NOT EXISTS player_id UUID REFERENCES players(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS assist_player_id UUID REFERENCES players(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS substitute_player_id UUID REFERENCES players(id) ON DELETE SET NULL;
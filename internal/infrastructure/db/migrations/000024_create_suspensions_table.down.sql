@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS suspensions;
+ALTER TABLE championships DROP COLUMN IF EXISTS disciplinary_rules;
@@ -0,0 +1,17 @@
+-- NULL mantém as suspensões padrão (uma partida a cada 3 amarelos ou por cartão vermelho)
+ALTER TABLE championships ADD COLUMN IF NOT EXISTS disciplinary_rules JSONB;
+
+CREATE TABLE IF NOT EXISTS suspensions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    championship_id UUID NOT NULL REFERENCES championships(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('yellow_cards', 'red_card')),
+    matches INTEGER NOT NULL CHECK (matches >= 1),
+    served_match_ids UUID[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_suspensions_championship_id ON suspensions(championship_id);
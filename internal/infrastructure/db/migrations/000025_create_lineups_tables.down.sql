@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS lineup_players;
+DROP TABLE IF EXISTS lineups;
+ALTER TABLE championships DROP COLUMN IF EXISTS squad_rules;
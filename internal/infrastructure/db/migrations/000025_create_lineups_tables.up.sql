@@ -0,0 +1,19 @@
+-- NULL mantém as escalações padrão (11 titulares, ao menos 7 para começar, e até 7 reservas)
+ALTER TABLE championships ADD COLUMN IF NOT EXISTS squad_rules JSONB;
+
+CREATE TABLE IF NOT EXISTS lineups (
+    match_id UUID NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    submitted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (match_id, team_id)
+);
+
+CREATE TABLE IF NOT EXISTS lineup_players (
+    match_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    player_id UUID NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    starter BOOLEAN NOT NULL,
+    sort_order INTEGER NOT NULL,
+    PRIMARY KEY (match_id, player_id),
+    FOREIGN KEY (match_id, team_id) REFERENCES lineups(match_id, team_id) ON DELETE CASCADE
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS cup_statistics;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS cup_statistics (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    championship_id UUID NOT NULL REFERENCES championships(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    matches_played INTEGER NOT NULL DEFAULT 0,
+    wins INTEGER NOT NULL DEFAULT 0,
+    draws INTEGER NOT NULL DEFAULT 0,
+    losses INTEGER NOT NULL DEFAULT 0,
+    goals_for INTEGER NOT NULL DEFAULT 0,
+    goals_against INTEGER NOT NULL DEFAULT 0,
+    extra_time_wins INTEGER NOT NULL DEFAULT 0,
+    extra_time_losses INTEGER NOT NULL DEFAULT 0,
+    shootout_wins INTEGER NOT NULL DEFAULT 0,
+    shootout_losses INTEGER NOT NULL DEFAULT 0,
+    furthest_phase INTEGER NOT NULL DEFAULT 0,
+    eliminated BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (championship_id, team_id)
+);
@@ -0,0 +1 @@
+ALTER TABLE statistics DROP CONSTRAINT IF EXISTS statistics_championship_team_key;
@@ -0,0 +1,10 @@
+-- Estatísticas repetidas do mesmo time ficam só com o registro de mais partidas; os números
+-- podem ser conferidos depois com o recálculo das estatísticas
+DELETE FROM statistics s
+USING statistics d
+WHERE s.championship_id = d.championship_id
+  AND s.team_id = d.team_id
+  AND (s.matches_played, s.created_at, s.id) < (d.matches_played, d.created_at, d.id);
+
+ALTER TABLE statistics
+    ADD CONSTRAINT statistics_championship_team_key UNIQUE (championship_id, team_id);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS standings_snapshots (
+    championship_id UUID NOT NULL REFERENCES championships(id) ON DELETE CASCADE,
+    round INTEGER NOT NULL CHECK (round >= 1),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    group_number INTEGER,
+    position INTEGER NOT NULL CHECK (position >= 1),
+    matches_played INTEGER NOT NULL DEFAULT 0,
+    wins INTEGER NOT NULL DEFAULT 0,
+    draws INTEGER NOT NULL DEFAULT 0,
+    losses INTEGER NOT NULL DEFAULT 0,
+    goals_for INTEGER NOT NULL DEFAULT 0,
+    goals_against INTEGER NOT NULL DEFAULT 0,
+    goal_difference INTEGER NOT NULL DEFAULT 0,
+    points INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (championship_id, round, team_id)
+);
+
+CREATE INDEX idx_standings_snapshots_team ON standings_snapshots(championship_id, team_id);
@@ -0,0 +1,67 @@
+package document
+
+import (
+	"champi-maker/internal/application/port"
+	"champi-maker/internal/domain/entity"
+	"embed"
+	"html/template"
+	"io"
+	"time"
+)
+
+//go:embed templates/match_sheet.html
+var templates embed.FS
+
+// sheetRows é o número mínimo de linhas de cada lista da súmula; as que sobram ficam em branco
+// para o preenchimento à mão
+const sheetRows = 18
+
+var positionLabels = map[entity.PlayerPosition]string{
+	entity.PlayerPositionGoalkeeper: "Goleiro",
+	entity.PlayerPositionDefender:   "Defensor",
+	entity.PlayerPositionMidfielder: "Meio-campo",
+	entity.PlayerPositionForward:    "Atacante",
+}
+
+// sheetSide é um dos lados da súmula: o time e a escalação enviada, que pode ser nula
+type sheetSide struct {
+	Team   *entity.Team
+	Lineup *entity.Lineup
+}
+
+type htmlMatchSheetRenderer struct {
+	template *template.Template
+}
+
+// NewHTMLMatchSheetRenderer cria o gerador da súmula em HTML, pronta para impressão em A4.
+func NewHTMLMatchSheetRenderer() (port.MatchSheetRenderer, error) {
+	tmpl, err := template.New("match_sheet.html").Funcs(template.FuncMap{
+		"position": func(position entity.PlayerPosition) string {
+			return positionLabels[position]
+		},
+		"date": func(t *time.Time) string {
+			if t == nil {
+				return ""
+			}
+			return t.Format("02/01/2006 15:04")
+		},
+		"side": func(team *entity.Team, lineup *entity.Lineup) sheetSide {
+			return sheetSide{Team: team, Lineup: lineup}
+		},
+		"blank": func(lineup *entity.Lineup) []struct{} {
+			filled := 0
+			if lineup != nil {
+				filled = len(lineup.Players)
+			}
+			return make([]struct{}, max(0, sheetRows-filled))
+		},
+	}).ParseFS(templates, "templates/match_sheet.html")
+	if err != nil {
+		return nil, err
+	}
+	return &htmlMatchSheetRenderer{template: tmpl}, nil
+}
+
+func (r *htmlMatchSheetRenderer) RenderMatchSheet(w io.Writer, sheet *entity.MatchSheet) error {
+	return r.template.Execute(w, sheet)
+}
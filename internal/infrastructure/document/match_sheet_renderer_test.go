@@ -0,0 +1,50 @@
+package document
+
+import (
+	"bytes"
+	"champi-maker/internal/domain/entity"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTMLMatchSheetRenderer_RenderMatchSheet(t *testing.T) {
+	renderer, err := NewHTMLMatchSheetRenderer()
+	require.NoError(t, err)
+
+	matchDate := time.Date(2024, time.March, 10, 15, 30, 0, 0, time.UTC)
+	home := &entity.Team{ID: uuid.New(), Name: "Unidos da Vila"}
+	away := &entity.Team{ID: uuid.New(), Name: "Estrela & Cia"}
+	sheet := &entity.MatchSheet{
+		Championship: &entity.Championship{Name: "Copa do Bairro"},
+		Match:        &entity.Match{Phase: 1, Round: 3, MatchDate: &matchDate},
+		HomeTeam:     home,
+		AwayTeam:     away,
+		HomeLineup: &entity.Lineup{
+			TeamID: home.ID,
+			Players: []*entity.LineupPlayer{
+				{PlayerID: uuid.New(), Name: "Goleiro Titular", ShirtNumber: 1, Position: entity.PlayerPositionGoalkeeper, Starter: true},
+				{PlayerID: uuid.New(), Name: "Atacante Reserva", ShirtNumber: 19, Position: entity.PlayerPositionForward},
+			},
+		},
+	}
+
+	var document bytes.Buffer
+	err = renderer.RenderMatchSheet(&document, sheet)
+	require.NoError(t, err)
+
+	html := document.String()
+	assert.Contains(t, html, "Copa do Bairro")
+	assert.Contains(t, html, "Rodada 3")
+	assert.Contains(t, html, "10/03/2024 15:30")
+	assert.Contains(t, html, "Goleiro Titular")
+	assert.Contains(t, html, "Atacante Reserva")
+	assert.Contains(t, html, "Goleiro")
+	// Os nomes são escapados
+	assert.Contains(t, html, "Estrela &amp; Cia")
+	// O time visitante ainda não enviou a escalação, mas a súmula traz as linhas em branco
+	assert.Contains(t, html, "Capitão - Estrela &amp; Cia")
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type championshipPlayerRepositoryPg struct {
+	pool *pgxpool.Pool
+}
+
+func NewChampionshipPlayerRepositoryPg(pool *pgxpool.Pool) repository.ChampionshipPlayerRepository {
+	return &championshipPlayerRepositoryPg{pool: pool}
+}
+
+func (r *championshipPlayerRepositoryPg) Register(ctx context.Context, championshipPlayer *entity.ChampionshipPlayer) error {
+	query := `
+        INSERT INTO championship_players (championship_id, team_id, player_id, registered_at)
+        VALUES ($1, $2, $3, $4)
+    `
+	_, err := r.pool.Exec(ctx, query,
+		championshipPlayer.ChampionshipID,
+		championshipPlayer.TeamID,
+		championshipPlayer.PlayerID,
+		championshipPlayer.RegisteredAt,
+	)
+	return err
+}
+
+func (r *championshipPlayerRepositoryPg) Unregister(ctx context.Context, championshipID, playerID uuid.UUID) error {
+	query := `
+        DELETE FROM championship_players
+        WHERE championship_id = $1 AND player_id = $2
+    `
+	commandTag, err := r.pool.Exec(ctx, query, championshipID, playerID)
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() != 1 {
+		return errors.New("no rows were deleted")
+	}
+
+	return nil
+}
+
+func (r *championshipPlayerRepositoryPg) GetByChampionshipAndPlayer(ctx context.Context, championshipID, playerID uuid.UUID) (*entity.ChampionshipPlayer, error) {
+	query := `
+        SELECT championship_id, team_id, player_id, registered_at
+        FROM championship_players
+        WHERE championship_id = $1 AND player_id = $2
+    `
+	row := r.pool.QueryRow(ctx, query, championshipID, playerID)
+
+	var championshipPlayer entity.ChampionshipPlayer
+	err := row.Scan(
+		&championshipPlayer.ChampionshipID,
+		&championshipPlayer.TeamID,
+		&championshipPlayer.PlayerID,
+		&championshipPlayer.RegisteredAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil // Jogador não inscrito
+		}
+		return nil, err
+	}
+
+	return &championshipPlayer, nil
+}
+
+func (r *championshipPlayerRepositoryPg) ListPlayersByChampionshipAndTeam(ctx context.Context, championshipID, teamID uuid.UUID) ([]*entity.Player, error) {
+	query := `
+        SELECT p.id, p.team_id, p.name, p.shirt_number, p.position, p.date_of_birth, p.joined_at,
+               p.left_at, p.created_at, p.updated_at
+        FROM championship_players cp
+        JOIN players p ON p.id = cp.player_id
+        WHERE cp.championship_id = $1 AND cp.team_id = $2
+        ORDER BY p.shirt_number ASC
+    `
+	rows, err := r.pool.Query(ctx, query, championshipID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanPlayers(rows)
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChampionshipPlayerRepositoryPg_RegisterAndList(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	playerRepo := NewPlayerRepositoryPg(pool)
+	championshipPlayerRepo := NewChampionshipPlayerRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+
+	championshipID, err := createChampionship(uuid.New(), pool)
+	require.NoError(t, err)
+
+	teamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	player := &entity.Player{
+		ID:          uuid.New(),
+		TeamID:      teamID,
+		Name:        "Jogador",
+		ShirtNumber: 9,
+		Position:    entity.PlayerPositionForward,
+		JoinedAt:    time.Now(),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	err = playerRepo.Create(ctx, player)
+	require.NoError(t, err)
+
+	err = championshipPlayerRepo.Register(ctx, &entity.ChampionshipPlayer{
+		ChampionshipID: championshipID,
+		TeamID:         teamID,
+		PlayerID:       player.ID,
+		RegisteredAt:   time.Now(),
+	})
+	require.NoError(t, err)
+
+	registration, err := championshipPlayerRepo.GetByChampionshipAndPlayer(ctx, championshipID, player.ID)
+	require.NoError(t, err)
+	require.NotNil(t, registration)
+	assert.Equal(t, teamID, registration.TeamID)
+
+	squad, err := championshipPlayerRepo.ListPlayersByChampionshipAndTeam(ctx, championshipID, teamID)
+	require.NoError(t, err)
+	require.Len(t, squad, 1)
+	assert.Equal(t, player.ID, squad[0].ID)
+
+	err = championshipPlayerRepo.Unregister(ctx, championshipID, player.ID)
+	require.NoError(t, err)
+
+	registration, err = championshipPlayerRepo.GetByChampionshipAndPlayer(ctx, championshipID, player.ID)
+	require.NoError(t, err)
+	assert.Nil(t, registration)
+}
@@ -12,6 +12,48 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// championshipColumns lista as colunas de championships na ordem esperada por scanChampionship
+const championshipColumns = `
+            id, name, type, tiebreaker_method, progression_type, status, phases,
+            num_groups, qualifiers_per_group, legs, swiss_rounds,
+            double_elimination, bracket_reset, third_place_match, two_legged_ties,
+            two_legged_phases, away_goals, seeds, draw_seed, points_system, tiebreakers,
+            disqualification_rule, disciplinary_rules, squad_rules, created_at, updated_at`
+
+const insertChampionshipQuery = `
+        INSERT INTO championships (` + championshipColumns + `
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26)
+    `
+
+const updateChampionshipQuery = `
+        UPDATE championships
+        SET name = $1,
+            type = $2,
+            tiebreaker_method = $3,
+            progression_type = $4,
+            status = $5,
+            num_groups = $6,
+            qualifiers_per_group = $7,
+            legs = $8,
+            swiss_rounds = $9,
+            double_elimination = $10,
+            bracket_reset = $11,
+            third_place_match = $12,
+            two_legged_ties = $13,
+            two_legged_phases = $14,
+            away_goals = $15,
+            points_system = $16,
+            tiebreakers = $17,
+            disqualification_rule = $18,
+            disciplinary_rules = $19,
+            squad_rules = $20,
+            seeds = $21,
+            draw_seed = $22,
+            updated_at = $23
+        WHERE id = $24
+    `
+
 type championshipRepositoryPg struct {
 	pool *pgxpool.Pool
 }
@@ -20,34 +62,10 @@ func NewChampionshipRepositoryPg(pool *pgxpool.Pool) repository.ChampionshipRepo
 	return &championshipRepositoryPg{pool: pool}
 }
 
-func (r *championshipRepositoryPg) Create(ctx context.Context, championship *entity.Championship) error {
-	query := `
-        INSERT INTO championships (id, name, type, tiebreaker_method, progression_type, phases, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-    `
-	_, err := r.pool.Exec(ctx, query,
-		championship.ID,
-		championship.Name,
-		string(championship.Type),
-		string(championship.TiebreakerMethod),
-		string(championship.ProgressionType),
-		championship.Phases,
-		championship.CreatedAt,
-		championship.UpdatedAt,
-	)
-	return err
-}
-
-func (r *championshipRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*entity.Championship, error) {
-	query := `
-        SELECT id, name, type, tiebreaker_method, progression_type, phases, created_at, updated_at
-        FROM championships
-        WHERE id = $1
-    `
-	row := r.pool.QueryRow(ctx, query, id)
-
+func scanChampionship(row pgx.Row) (*entity.Championship, error) {
 	var championship entity.Championship
-	var typeStr, tiebreakerMethodStr, progressionTypeStr string
+	var typeStr, tiebreakerMethodStr, progressionTypeStr, statusStr, disqualificationRuleStr string
+	var tiebreakers []string
 
 	err := row.Scan(
 		&championship.ID,
@@ -55,14 +73,29 @@ func (r *championshipRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*
 		&typeStr,
 		&tiebreakerMethodStr,
 		&progressionTypeStr,
+		&statusStr,
 		&championship.Phases,
+		&championship.NumGroups,
+		&championship.QualifiersPerGroup,
+		&championship.Legs,
+		&championship.SwissRounds,
+		&championship.DoubleElimination,
+		&championship.BracketReset,
+		&championship.ThirdPlaceMatch,
+		&championship.TwoLeggedTies,
+		&championship.TwoLeggedPhases,
+		&championship.AwayGoals,
+		&championship.Seeds,
+		&championship.DrawSeed,
+		&championship.PointsSystem,
+		&tiebreakers,
+		&disqualificationRuleStr,
+		&championship.DisciplinaryRules,
+		&championship.SquadRules,
 		&championship.CreatedAt,
 		&championship.UpdatedAt,
 	)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil // Campeonato não encontrado
-		}
 		return nil, err
 	}
 
@@ -70,28 +103,176 @@ func (r *championshipRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*
 	championship.Type = entity.ChampionshipType(typeStr)
 	championship.TiebreakerMethod = entity.TiebreakerMethod(tiebreakerMethodStr)
 	championship.ProgressionType = entity.ProgressionType(progressionTypeStr)
+	championship.Status = entity.ChampionshipStatus(statusStr)
+	championship.DisqualificationRule = entity.DisqualificationRule(disqualificationRuleStr)
+	for _, criterion := range tiebreakers {
+		championship.Tiebreakers = append(championship.Tiebreakers, entity.StandingsCriterion(criterion))
+	}
 
 	return &championship, nil
 }
 
-func (r *championshipRepositoryPg) Update(ctx context.Context, championship *entity.Championship) error {
-	query := `
-        UPDATE championships
-        SET name = $1,
-            type = $2,
-            tiebreaker_method = $3,
-            progression_type = $4,
-            updated_at = $5
-        WHERE id = $6
-    `
-	commandTag, err := r.pool.Exec(ctx, query,
+// championshipValues devolve os valores de championshipColumns para o INSERT
+func championshipValues(championship *entity.Championship) []any {
+	return []any{
+		championship.ID,
+		championship.Name,
+		string(championship.Type),
+		string(championship.TiebreakerMethod),
+		string(championship.ProgressionType),
+		string(championshipStatus(championship)),
+		championship.Phases,
+		championship.NumGroups,
+		championship.QualifiersPerGroup,
+		championship.NumLegs(),
+		championship.SwissRounds,
+		championship.DoubleElimination,
+		championship.BracketReset,
+		championship.ThirdPlaceMatch,
+		championship.TwoLeggedTies,
+		twoLeggedPhases(championship),
+		championship.AwayGoals,
+		seeds(championship),
+		championship.DrawSeed,
+		championship.PointsSystem,
+		tiebreakers(championship),
+		string(championship.DisqualificationRule),
+		championship.DisciplinaryRules,
+		championship.SquadRules,
+		championship.CreatedAt,
+		championship.UpdatedAt,
+	}
+}
+
+// championshipUpdateValues devolve os valores de updateChampionshipQuery
+func championshipUpdateValues(championship *entity.Championship) []any {
+	return []any{
 		championship.Name,
 		string(championship.Type),
 		string(championship.TiebreakerMethod),
 		string(championship.ProgressionType),
+		string(championshipStatus(championship)),
+		championship.NumGroups,
+		championship.QualifiersPerGroup,
+		championship.NumLegs(),
+		championship.SwissRounds,
+		championship.DoubleElimination,
+		championship.BracketReset,
+		championship.ThirdPlaceMatch,
+		championship.TwoLeggedTies,
+		twoLeggedPhases(championship),
+		championship.AwayGoals,
+		championship.PointsSystem,
+		tiebreakers(championship),
+		string(championship.DisqualificationRule),
+		championship.DisciplinaryRules,
+		championship.SquadRules,
+		seeds(championship),
+		championship.DrawSeed,
 		time.Now(),
 		championship.ID,
-	)
+	}
+}
+
+// championshipStatus considera em rascunho os campeonatos sem status definido
+func championshipStatus(championship *entity.Championship) entity.ChampionshipStatus {
+	if championship.Status == "" {
+		return entity.ChampionshipStatusDraft
+	}
+	return championship.Status
+}
+
+// twoLeggedPhases evita gravar NULL na coluna NOT NULL quando nenhuma fase foi selecionada
+func twoLeggedPhases(championship *entity.Championship) []int {
+	if championship.TwoLeggedPhases == nil {
+		return []int{}
+	}
+	return championship.TwoLeggedPhases
+}
+
+// seeds evita gravar NULL na coluna NOT NULL quando não há cabeças de chave
+func seeds(championship *entity.Championship) []uuid.UUID {
+	if championship.Seeds == nil {
+		return []uuid.UUID{}
+	}
+	return championship.Seeds
+}
+
+// tiebreakers converte os critérios de desempate para a coluna TEXT[], que não aceita NULL
+func tiebreakers(championship *entity.Championship) []string {
+	criteria := make([]string, len(championship.Tiebreakers))
+	for i, criterion := range championship.Tiebreakers {
+		criteria[i] = string(criterion)
+	}
+	return criteria
+}
+
+func (r *championshipRepositoryPg) Create(ctx context.Context, championship *entity.Championship) error {
+	_, err := r.pool.Exec(ctx, insertChampionshipQuery, championshipValues(championship)...)
+	return err
+}
+
+func (r *championshipRepositoryPg) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.pool.Begin(ctx)
+}
+
+func (r *championshipRepositoryPg) CreateWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error {
+	_, err := tx.Exec(ctx, insertChampionshipQuery, championshipValues(championship)...)
+	return err
+}
+
+func (r *championshipRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*entity.Championship, error) {
+	query := `
+        SELECT` + championshipColumns + `
+        FROM championships
+        WHERE id = $1
+    `
+	championship, err := scanChampionship(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil // Campeonato não encontrado
+		}
+		return nil, err
+	}
+
+	return championship, nil
+}
+
+// GetByIDWithTx busca o campeonato travando a linha até o fim da transação, o que serializa as
+// operações que geram partidas a partir do estado atual do campeonato.
+func (r *championshipRepositoryPg) GetByIDWithTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*entity.Championship, error) {
+	query := `
+        SELECT` + championshipColumns + `
+        FROM championships
+        WHERE id = $1
+        FOR UPDATE
+    `
+	championship, err := scanChampionship(tx.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil // Campeonato não encontrado
+		}
+		return nil, err
+	}
+
+	return championship, nil
+}
+
+func (r *championshipRepositoryPg) Update(ctx context.Context, championship *entity.Championship) error {
+	commandTag, err := r.pool.Exec(ctx, updateChampionshipQuery, championshipUpdateValues(championship)...)
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() != 1 {
+		return errors.New("no rows were updated")
+	}
+
+	return nil
+}
+
+func (r *championshipRepositoryPg) UpdateWithTx(ctx context.Context, tx pgx.Tx, championship *entity.Championship) error {
+	commandTag, err := tx.Exec(ctx, updateChampionshipQuery, championshipUpdateValues(championship)...)
 	if err != nil {
 		return err
 	}
@@ -122,7 +303,7 @@ func (r *championshipRepositoryPg) Delete(ctx context.Context, id uuid.UUID) err
 
 func (r *championshipRepositoryPg) List(ctx context.Context) ([]*entity.Championship, error) {
 	query := `
-        SELECT id, name, type, tiebreaker_method, progression_type, phases, created_at, updated_at
+        SELECT` + championshipColumns + `
         FROM championships
         ORDER BY created_at DESC
     `
@@ -135,29 +316,11 @@ func (r *championshipRepositoryPg) List(ctx context.Context) ([]*entity.Champion
 	var championships []*entity.Championship
 
 	for rows.Next() {
-		var championship entity.Championship
-		var typeStr, tiebreakerMethodStr, progressionTypeStr string
-
-		err := rows.Scan(
-			&championship.ID,
-			&championship.Name,
-			&typeStr,
-			&tiebreakerMethodStr,
-			&progressionTypeStr,
-			&championship.Phases,
-			&championship.CreatedAt,
-			&championship.UpdatedAt,
-		)
+		championship, err := scanChampionship(rows)
 		if err != nil {
 			return nil, err
 		}
-
-		// Converter strings para tipos enumerados
-		championship.Type = entity.ChampionshipType(typeStr)
-		championship.TiebreakerMethod = entity.TiebreakerMethod(tiebreakerMethodStr)
-		championship.ProgressionType = entity.ProgressionType(progressionTypeStr)
-
-		championships = append(championships, &championship)
+		championships = append(championships, championship)
 	}
 
 	if err = rows.Err(); err != nil {
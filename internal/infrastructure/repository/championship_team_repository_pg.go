@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type championshipTeamRepositoryPg struct {
+	pool *pgxpool.Pool
+}
+
+func NewChampionshipTeamRepositoryPg(pool *pgxpool.Pool) repository.ChampionshipTeamRepository {
+	return &championshipTeamRepositoryPg{pool: pool}
+}
+
+const enrollChampionshipTeamQuery = `
+        INSERT INTO championship_teams (championship_id, team_id, enrolled_at)
+        VALUES ($1, $2, $3)
+    `
+
+func (r *championshipTeamRepositoryPg) Enroll(ctx context.Context, championshipTeam *entity.ChampionshipTeam) error {
+	_, err := r.pool.Exec(ctx, enrollChampionshipTeamQuery,
+		championshipTeam.ChampionshipID,
+		championshipTeam.TeamID,
+		championshipTeam.EnrolledAt,
+	)
+	return err
+}
+
+func (r *championshipTeamRepositoryPg) EnrollWithTx(ctx context.Context, tx pgx.Tx, championshipTeam *entity.ChampionshipTeam) error {
+	_, err := tx.Exec(ctx, enrollChampionshipTeamQuery,
+		championshipTeam.ChampionshipID,
+		championshipTeam.TeamID,
+		championshipTeam.EnrolledAt,
+	)
+	return err
+}
+
+func (r *championshipTeamRepositoryPg) Withdraw(ctx context.Context, championshipID, teamID uuid.UUID) error {
+	query := `
+        DELETE FROM championship_teams
+        WHERE championship_id = $1 AND team_id = $2
+    `
+	commandTag, err := r.pool.Exec(ctx, query, championshipID, teamID)
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() != 1 {
+		return errors.New("no rows were deleted")
+	}
+
+	return nil
+}
+
+func (r *championshipTeamRepositoryPg) GetByChampionshipAndTeam(ctx context.Context, championshipID, teamID uuid.UUID) (*entity.ChampionshipTeam, error) {
+	query := `
+        SELECT championship_id, team_id, enrolled_at
+        FROM championship_teams
+        WHERE championship_id = $1 AND team_id = $2
+    `
+	row := r.pool.QueryRow(ctx, query, championshipID, teamID)
+
+	var championshipTeam entity.ChampionshipTeam
+	err := row.Scan(
+		&championshipTeam.ChampionshipID,
+		&championshipTeam.TeamID,
+		&championshipTeam.EnrolledAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil // Time não inscrito
+		}
+		return nil, err
+	}
+
+	return &championshipTeam, nil
+}
+
+func (r *championshipTeamRepositoryPg) ListTeamsByChampionshipID(ctx context.Context, championshipID uuid.UUID) ([]*entity.Team, error) {
+	query := `
+        SELECT t.id, t.name, t.logo, t.user_id, t.created_at, t.updated_at
+        FROM championship_teams ct
+        JOIN teams t ON t.id = ct.team_id
+        WHERE ct.championship_id = $1
+        ORDER BY ct.enrolled_at, t.name
+    `
+	rows, err := r.pool.Query(ctx, query, championshipID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []*entity.Team
+	for rows.Next() {
+		var team entity.Team
+		err := rows.Scan(
+			&team.ID,
+			&team.Name,
+			&team.Logo,
+			&team.UserID,
+			&team.CreatedAt,
+			&team.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, &team)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
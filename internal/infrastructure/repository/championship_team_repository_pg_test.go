@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChampionshipTeamRepositoryPg_EnrollAndList(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	championshipTeamRepo := NewChampionshipTeamRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+	championshipID, err := createChampionship(uuid.New(), pool)
+	require.NoError(t, err)
+	teamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	// Teste de inscrição
+	err = championshipTeamRepo.Enroll(ctx, &entity.ChampionshipTeam{
+		ChampionshipID: championshipID,
+		TeamID:         teamID,
+		EnrolledAt:     time.Now(),
+	})
+	require.NoError(t, err)
+
+	// Teste de recuperação da inscrição
+	championshipTeam, err := championshipTeamRepo.GetByChampionshipAndTeam(ctx, championshipID, teamID)
+	require.NoError(t, err)
+	require.NotNil(t, championshipTeam)
+	assert.Equal(t, teamID, championshipTeam.TeamID)
+
+	// Teste de listagem dos times inscritos
+	teams, err := championshipTeamRepo.ListTeamsByChampionshipID(ctx, championshipID)
+	require.NoError(t, err)
+	require.Len(t, teams, 1)
+	assert.Equal(t, teamID, teams[0].ID)
+}
+
+func TestChampionshipTeamRepositoryPg_Withdraw(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	championshipTeamRepo := NewChampionshipTeamRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+	championshipID, err := createChampionship(uuid.New(), pool)
+	require.NoError(t, err)
+	teamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	err = championshipTeamRepo.Enroll(ctx, &entity.ChampionshipTeam{
+		ChampionshipID: championshipID,
+		TeamID:         teamID,
+		EnrolledAt:     time.Now(),
+	})
+	require.NoError(t, err)
+
+	// Teste de desistência
+	err = championshipTeamRepo.Withdraw(ctx, championshipID, teamID)
+	require.NoError(t, err)
+
+	championshipTeam, err := championshipTeamRepo.GetByChampionshipAndTeam(ctx, championshipID, teamID)
+	require.NoError(t, err)
+	assert.Nil(t, championshipTeam)
+
+	// Desistir de novo deve falhar
+	err = championshipTeamRepo.Withdraw(ctx, championshipID, teamID)
+	assert.Error(t, err)
+}
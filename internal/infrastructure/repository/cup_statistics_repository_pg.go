@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type cupStatisticsRepositoryPg struct {
+	pool *pgxpool.Pool
+}
+
+func NewCupStatisticsRepositoryPg(pool *pgxpool.Pool) repository.CupStatisticsRepository {
+	return &cupStatisticsRepositoryPg{pool: pool}
+}
+
+const cupStatisticsColumns = `
+            id, championship_id, team_id, matches_played, wins, draws, losses, goals_for,
+            goals_against, extra_time_wins, extra_time_losses, shootout_wins, shootout_losses,
+            furthest_phase, eliminated, created_at, updated_at`
+
+func (r *cupStatisticsRepositoryPg) ListByChampionship(ctx context.Context, championshipID uuid.UUID) ([]*entity.CupStatistics, error) {
+	query := `
+        SELECT` + cupStatisticsColumns + `
+        FROM cup_statistics
+        WHERE championship_id = $1
+    `
+	rows, err := r.pool.Query(ctx, query, championshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanCupStatisticsList(rows)
+}
+
+func (r *cupStatisticsRepositoryPg) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.pool.Begin(ctx)
+}
+
+// ListByChampionshipWithTx lista as estatísticas do mata-mata travando as linhas até o fim da
+// transação.
+func (r *cupStatisticsRepositoryPg) ListByChampionshipWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) ([]*entity.CupStatistics, error) {
+	query := `
+        SELECT` + cupStatisticsColumns + `
+        FROM cup_statistics
+        WHERE championship_id = $1
+        FOR UPDATE
+    `
+	rows, err := tx.Query(ctx, query, championshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanCupStatisticsList(rows)
+}
+
+func (r *cupStatisticsRepositoryPg) CreateWithTx(ctx context.Context, tx pgx.Tx, stats *entity.CupStatistics) error {
+	query := `
+        INSERT INTO cup_statistics (` + cupStatisticsColumns + `
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+    `
+	_, err := tx.Exec(ctx, query,
+		stats.ID,
+		stats.ChampionshipID,
+		stats.TeamID,
+		stats.MatchesPlayed,
+		stats.Wins,
+		stats.Draws,
+		stats.Losses,
+		stats.GoalsFor,
+		stats.GoalsAgainst,
+		stats.ExtraTimeWins,
+		stats.ExtraTimeLosses,
+		stats.ShootoutWins,
+		stats.ShootoutLosses,
+		stats.FurthestPhase,
+		stats.Eliminated,
+		stats.CreatedAt,
+		stats.UpdatedAt,
+	)
+	return err
+}
+
+func (r *cupStatisticsRepositoryPg) DeleteByChampionshipWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) error {
+	query := `
+        DELETE FROM cup_statistics
+        WHERE championship_id = $1
+    `
+	_, err := tx.Exec(ctx, query, championshipID)
+	return err
+}
+
+func scanCupStatisticsList(rows pgx.Rows) ([]*entity.CupStatistics, error) {
+	defer rows.Close()
+
+	var statsList []*entity.CupStatistics
+	for rows.Next() {
+		var stats entity.CupStatistics
+		err := rows.Scan(
+			&stats.ID,
+			&stats.ChampionshipID,
+			&stats.TeamID,
+			&stats.MatchesPlayed,
+			&stats.Wins,
+			&stats.Draws,
+			&stats.Losses,
+			&stats.GoalsFor,
+			&stats.GoalsAgainst,
+			&stats.ExtraTimeWins,
+			&stats.ExtraTimeLosses,
+			&stats.ShootoutWins,
+			&stats.ShootoutLosses,
+			&stats.FurthestPhase,
+			&stats.Eliminated,
+			&stats.CreatedAt,
+			&stats.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		statsList = append(statsList, &stats)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return statsList, nil
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCupStatisticsRepositoryPg_CreateListAndDelete(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	cupStatisticsRepo := NewCupStatisticsRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+
+	championshipID, err := createChampionship(uuid.New(), pool)
+	require.NoError(t, err)
+
+	teamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	stats := &entity.CupStatistics{
+		ID:             uuid.New(),
+		ChampionshipID: championshipID,
+		TeamID:         teamID,
+		MatchesPlayed:  3,
+		Wins:           2,
+		Draws:          1,
+		GoalsFor:       5,
+		GoalsAgainst:   2,
+		ExtraTimeWins:  1,
+		ShootoutWins:   1,
+		FurthestPhase:  3,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	tx, err := cupStatisticsRepo.BeginTx(ctx)
+	require.NoError(t, err)
+	err = cupStatisticsRepo.CreateWithTx(ctx, tx, stats)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit(ctx))
+
+	statsList, err := cupStatisticsRepo.ListByChampionship(ctx, championshipID)
+	require.NoError(t, err)
+	require.Len(t, statsList, 1)
+	assert.Equal(t, stats.ID, statsList[0].ID)
+	assert.Equal(t, 1, statsList[0].ExtraTimeWins)
+	assert.Equal(t, 1, statsList[0].ShootoutWins)
+	assert.Equal(t, 3, statsList[0].FurthestPhase)
+	assert.False(t, statsList[0].Eliminated)
+
+	tx, err = cupStatisticsRepo.BeginTx(ctx)
+	require.NoError(t, err)
+	locked, err := cupStatisticsRepo.ListByChampionshipWithTx(ctx, tx, championshipID)
+	require.NoError(t, err)
+	assert.Len(t, locked, 1)
+	err = cupStatisticsRepo.DeleteByChampionshipWithTx(ctx, tx, championshipID)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit(ctx))
+
+	statsList, err = cupStatisticsRepo.ListByChampionship(ctx, championshipID)
+	require.NoError(t, err)
+	assert.Empty(t, statsList)
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// lineupQuery traz uma linha por jogador relacionado, com os dados do elenco, na ordem em que
+// scanLineups agrupa as escalações
+const lineupQuery = `
+        SELECT l.match_id, l.team_id, l.submitted_at, lp.player_id, p.name, p.shirt_number,
+               p.position, lp.starter
+        FROM lineups l
+        JOIN lineup_players lp ON lp.match_id = l.match_id AND lp.team_id = l.team_id
+        JOIN players p ON p.id = lp.player_id
+    `
+
+const lineupOrder = `
+        ORDER BY l.match_id, l.team_id, lp.sort_order ASC
+    `
+
+type lineupRepositoryPg struct {
+	pool *pgxpool.Pool
+}
+
+func NewLineupRepositoryPg(pool *pgxpool.Pool) repository.LineupRepository {
+	return &lineupRepositoryPg{pool: pool}
+}
+
+func scanLineups(rows pgx.Rows) ([]*entity.Lineup, error) {
+	defer rows.Close()
+
+	var lineups []*entity.Lineup
+	var current *entity.Lineup
+	for rows.Next() {
+		var lineup entity.Lineup
+		var player entity.LineupPlayer
+		var positionStr string
+		err := rows.Scan(
+			&lineup.MatchID,
+			&lineup.TeamID,
+			&lineup.SubmittedAt,
+			&player.PlayerID,
+			&player.Name,
+			&player.ShirtNumber,
+			&positionStr,
+			&player.Starter,
+		)
+		if err != nil {
+			return nil, err
+		}
+		player.Position = entity.PlayerPosition(positionStr)
+
+		if current == nil || current.MatchID != lineup.MatchID || current.TeamID != lineup.TeamID {
+			current = &lineup
+			lineups = append(lineups, current)
+		}
+		current.Players = append(current.Players, &player)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return lineups, nil
+}
+
+func (r *lineupRepositoryPg) GetByMatchAndTeam(ctx context.Context, matchID, teamID uuid.UUID) (*entity.Lineup, error) {
+	rows, err := r.pool.Query(ctx, lineupQuery+`WHERE l.match_id = $1 AND l.team_id = $2`+lineupOrder, matchID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	lineups, err := scanLineups(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(lineups) == 0 {
+		return nil, nil // Escalação não enviada
+	}
+	return lineups[0], nil
+}
+
+func (r *lineupRepositoryPg) ListByMatch(ctx context.Context, matchID uuid.UUID) ([]*entity.Lineup, error) {
+	rows, err := r.pool.Query(ctx, lineupQuery+`WHERE l.match_id = $1`+lineupOrder, matchID)
+	if err != nil {
+		return nil, err
+	}
+	return scanLineups(rows)
+}
+
+func (r *lineupRepositoryPg) ListByChampionshipIDWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) ([]*entity.Lineup, error) {
+	query := lineupQuery + `
+        JOIN matches m ON m.id = l.match_id
+        WHERE m.championship_id = $1` + lineupOrder
+	rows, err := tx.Query(ctx, query, championshipID)
+	if err != nil {
+		return nil, err
+	}
+	return scanLineups(rows)
+}
+
+// SaveWithTx grava a escalação, substituindo a enviada antes pelo time para a mesma partida.
+func (r *lineupRepositoryPg) SaveWithTx(ctx context.Context, tx pgx.Tx, lineup *entity.Lineup) error {
+	query := `
+        INSERT INTO lineups (match_id, team_id, submitted_at)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (match_id, team_id) DO UPDATE SET submitted_at = EXCLUDED.submitted_at
+    `
+	if _, err := tx.Exec(ctx, query, lineup.MatchID, lineup.TeamID, lineup.SubmittedAt); err != nil {
+		return err
+	}
+
+	query = `
+        DELETE FROM lineup_players
+        WHERE match_id = $1 AND team_id = $2
+    `
+	if _, err := tx.Exec(ctx, query, lineup.MatchID, lineup.TeamID); err != nil {
+		return err
+	}
+
+	query = `
+        INSERT INTO lineup_players (match_id, team_id, player_id, starter, sort_order)
+        VALUES ($1, $2, $3, $4, $5)
+    `
+	for i, player := range lineup.Players {
+		if _, err := tx.Exec(ctx, query, lineup.MatchID, lineup.TeamID, player.PlayerID, player.Starter, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *lineupRepositoryPg) DeleteWithTx(ctx context.Context, tx pgx.Tx, matchID, teamID uuid.UUID) error {
+	query := `
+        DELETE FROM lineups
+        WHERE match_id = $1 AND team_id = $2
+    `
+	commandTag, err := tx.Exec(ctx, query, matchID, teamID)
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() != 1 {
+		return errors.New("no rows were deleted")
+	}
+
+	return nil
+}
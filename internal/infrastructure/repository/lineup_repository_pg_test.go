@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineupRepositoryPg_SaveListAndDelete(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	matchRepo := NewMatchRepositoryPg(pool)
+	playerRepo := NewPlayerRepositoryPg(pool)
+	lineupRepo := NewLineupRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+
+	championshipID, err := createChampionship(uuid.New(), pool)
+	require.NoError(t, err)
+
+	homeTeamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	awayTeamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	match := &entity.Match{
+		ID:             uuid.New(),
+		ChampionshipID: championshipID,
+		HomeTeamID:     &homeTeamID,
+		AwayTeamID:     &awayTeamID,
+		Status:         entity.MatchStatusScheduled,
+		Phase:          1,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	err = matchRepo.Create(ctx, match)
+	require.NoError(t, err)
+
+	var players []*entity.Player
+	for i, position := range []entity.PlayerPosition{entity.PlayerPositionGoalkeeper, entity.PlayerPositionForward, entity.PlayerPositionDefender} {
+		player := &entity.Player{
+			ID:          uuid.New(),
+			TeamID:      homeTeamID,
+			Name:        "Jogador",
+			ShirtNumber: i + 1,
+			Position:    position,
+			JoinedAt:    time.Now(),
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		}
+		err = playerRepo.Create(ctx, player)
+		require.NoError(t, err)
+		players = append(players, player)
+	}
+
+	lineup := &entity.Lineup{
+		MatchID: match.ID,
+		TeamID:  homeTeamID,
+		Players: []*entity.LineupPlayer{
+			{PlayerID: players[1].ID, Starter: true},
+			{PlayerID: players[0].ID, Starter: true},
+			{PlayerID: players[2].ID},
+		},
+		SubmittedAt: time.Now(),
+	}
+	tx, err := matchRepo.BeginTx(ctx)
+	require.NoError(t, err)
+	err = lineupRepo.SaveWithTx(ctx, tx, lineup)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit(ctx))
+
+	retrieved, err := lineupRepo.GetByMatchAndTeam(ctx, match.ID, homeTeamID)
+	require.NoError(t, err)
+	require.NotNil(t, retrieved)
+	require.Len(t, retrieved.Players, 3)
+	// A ordem de envio é mantida e os dados vêm do elenco
+	assert.Equal(t, players[1].ID, retrieved.Players[0].PlayerID)
+	assert.Equal(t, entity.PlayerPositionForward, retrieved.Players[0].Position)
+	assert.Equal(t, 2, retrieved.Players[0].ShirtNumber)
+	assert.False(t, retrieved.Players[2].Starter)
+
+	// Reenviar a escalação substitui a anterior
+	lineup.Players = lineup.Players[:2]
+	tx, err = matchRepo.BeginTx(ctx)
+	require.NoError(t, err)
+	err = lineupRepo.SaveWithTx(ctx, tx, lineup)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit(ctx))
+
+	lineups, err := lineupRepo.ListByMatch(ctx, match.ID)
+	require.NoError(t, err)
+	require.Len(t, lineups, 1)
+	assert.Len(t, lineups[0].Players, 2)
+
+	tx, err = matchRepo.BeginTx(ctx)
+	require.NoError(t, err)
+	err = lineupRepo.DeleteWithTx(ctx, tx, match.ID, homeTeamID)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit(ctx))
+
+	retrieved, err = lineupRepo.GetByMatchAndTeam(ctx, match.ID, homeTeamID)
+	require.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// matchEventColumns lista as colunas de match_events na ordem esperada por scanMatchEvent e matchEventValues
+const matchEventColumns = `
+            id, match_id, team_id, type, minute, extra_time, player_id, player_name,
+            assist_player_id, substitute_player_id, substitute_player_name, created_at`
+
+// matchEventColumnsQualified é matchEventColumns com o alias de match_events, para consultas
+// com join
+const matchEventColumnsQualified = `
+            e.id, e.match_id, e.team_id, e.type, e.minute, e.extra_time, e.player_id, e.player_name,
+            e.assist_player_id, e.substitute_player_id, e.substitute_player_name, e.created_at`
+
+const insertMatchEventQuery = `
+        INSERT INTO match_events (` + matchEventColumns + `
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+    `
+
+// Os eventos saem na ordem em que aconteceram: tempo normal, prorrogação e, no mesmo minuto,
+// a ordem de registro
+const listMatchEventsQuery = `
+        SELECT` + matchEventColumns + `
+        FROM match_events
+        WHERE match_id = $1
+        ORDER BY extra_time ASC, minute ASC, created_at ASC
+    `
+
+const deleteMatchEventQuery = `
+        DELETE FROM match_events
+        WHERE id = $1
+    `
+
+type matchEventRepositoryPg struct {
+	pool *pgxpool.Pool
+}
+
+func NewMatchEventRepositoryPg(pool *pgxpool.Pool) repository.MatchEventRepository {
+	return &matchEventRepositoryPg{pool: pool}
+}
+
+func matchEventValues(event *entity.MatchEvent) []any {
+	return []any{
+		event.ID,
+		event.MatchID,
+		event.TeamID,
+		event.Type,
+		event.Minute,
+		event.ExtraTime,
+		event.PlayerID,
+		event.PlayerName,
+		event.AssistPlayerID,
+		event.SubstitutePlayerID,
+		event.SubstitutePlayerName,
+		event.CreatedAt,
+	}
+}
+
+func scanMatchEvent(row pgx.Row) (*entity.MatchEvent, error) {
+	var event entity.MatchEvent
+	err := row.Scan(
+		&event.ID,
+		&event.MatchID,
+		&event.TeamID,
+		&event.Type,
+		&event.Minute,
+		&event.ExtraTime,
+		&event.PlayerID,
+		&event.PlayerName,
+		&event.AssistPlayerID,
+		&event.SubstitutePlayerID,
+		&event.SubstitutePlayerName,
+		&event.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func scanMatchEvents(rows pgx.Rows) ([]*entity.MatchEvent, error) {
+	defer rows.Close()
+
+	var events []*entity.MatchEvent
+	for rows.Next() {
+		event, err := scanMatchEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *matchEventRepositoryPg) Create(ctx context.Context, event *entity.MatchEvent) error {
+	_, err := r.pool.Exec(ctx, insertMatchEventQuery, matchEventValues(event)...)
+	return err
+}
+
+func (r *matchEventRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*entity.MatchEvent, error) {
+	query := `
+        SELECT` + matchEventColumns + `
+        FROM match_events
+        WHERE id = $1
+    `
+	event, err := scanMatchEvent(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil // Evento não encontrado
+		}
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (r *matchEventRepositoryPg) Delete(ctx context.Context, id uuid.UUID) error {
+	commandTag, err := r.pool.Exec(ctx, deleteMatchEventQuery, id)
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() != 1 {
+		return errors.New("no rows were deleted")
+	}
+
+	return nil
+}
+
+func (r *matchEventRepositoryPg) ListByMatchID(ctx context.Context, matchID uuid.UUID) ([]*entity.MatchEvent, error) {
+	rows, err := r.pool.Query(ctx, listMatchEventsQuery, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMatchEvents(rows)
+}
+
+func (r *matchEventRepositoryPg) CreateWithTx(ctx context.Context, tx pgx.Tx, event *entity.MatchEvent) error {
+	_, err := tx.Exec(ctx, insertMatchEventQuery, matchEventValues(event)...)
+	return err
+}
+
+func (r *matchEventRepositoryPg) DeleteWithTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	commandTag, err := tx.Exec(ctx, deleteMatchEventQuery, id)
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() != 1 {
+		return errors.New("no rows were deleted")
+	}
+
+	return nil
+}
+
+func (r *matchEventRepositoryPg) ListByMatchIDWithTx(ctx context.Context, tx pgx.Tx, matchID uuid.UUID) ([]*entity.MatchEvent, error) {
+	rows, err := tx.Query(ctx, listMatchEventsQuery, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMatchEvents(rows)
+}
+
+func (r *matchEventRepositoryPg) ListByChampionshipIDWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) ([]*entity.MatchEvent, error) {
+	query := `
+        SELECT` + matchEventColumnsQualified + `
+        FROM match_events e
+        JOIN matches m ON m.id = e.match_id
+        WHERE m.championship_id = $1
+        ORDER BY e.match_id, e.extra_time ASC, e.minute ASC, e.created_at ASC
+    `
+	rows, err := tx.Query(ctx, query, championshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMatchEvents(rows)
+}
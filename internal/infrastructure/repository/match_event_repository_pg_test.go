@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchEventRepositoryPg_CreateListAndDelete(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	matchRepo := NewMatchRepositoryPg(pool)
+	matchEventRepo := NewMatchEventRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+
+	championshipID, err := createChampionship(uuid.New(), pool)
+	require.NoError(t, err)
+
+	homeTeamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	awayTeamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	match := &entity.Match{
+		ID:             uuid.New(),
+		ChampionshipID: championshipID,
+		HomeTeamID:     &homeTeamID,
+		AwayTeamID:     &awayTeamID,
+		Status:         entity.MatchStatusInProgress,
+		Phase:          1,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	err = matchRepo.Create(ctx, match)
+	require.NoError(t, err)
+
+	// Registrados fora de ordem para conferir a ordenação da listagem
+	substitution := &entity.MatchEvent{
+		ID:                   uuid.New(),
+		MatchID:              match.ID,
+		TeamID:               awayTeamID,
+		Type:                 entity.MatchEventSubstitution,
+		Minute:               70,
+		PlayerName:           "Jogador A",
+		SubstitutePlayerName: "Jogador B",
+		CreatedAt:            time.Now(),
+	}
+	goal := &entity.MatchEvent{
+		ID:         uuid.New(),
+		MatchID:    match.ID,
+		TeamID:     homeTeamID,
+		Type:       entity.MatchEventGoal,
+		Minute:     12,
+		PlayerName: "Jogador C",
+		CreatedAt:  time.Now(),
+	}
+	err = matchEventRepo.Create(ctx, substitution)
+	require.NoError(t, err)
+	err = matchEventRepo.Create(ctx, goal)
+	require.NoError(t, err)
+
+	retrievedEvent, err := matchEventRepo.GetByID(ctx, substitution.ID)
+	require.NoError(t, err)
+	require.NotNil(t, retrievedEvent)
+	assert.Equal(t, substitution.Type, retrievedEvent.Type)
+	assert.Equal(t, substitution.SubstitutePlayerName, retrievedEvent.SubstitutePlayerName)
+
+	events, err := matchEventRepo.ListByMatchID(ctx, match.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, goal.ID, events[0].ID)
+	assert.Equal(t, substitution.ID, events[1].ID)
+
+	// Teste de exclusão
+	err = matchEventRepo.Delete(ctx, goal.ID)
+	require.NoError(t, err)
+
+	deletedEvent, err := matchEventRepo.GetByID(ctx, goal.ID)
+	require.NoError(t, err)
+	assert.Nil(t, deletedEvent)
+
+	err = matchEventRepo.Delete(ctx, goal.ID)
+	assert.Error(t, err)
+}
@@ -12,31 +12,67 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-type matchRepositoryPg struct {
-	pool *pgxpool.Pool
-}
-
-func NewMatchRepositoryPg(pool *pgxpool.Pool) repository.MatchRepository {
-	return &matchRepositoryPg{pool: pool}
-}
-
-func (r *matchRepositoryPg) Create(ctx context.Context, match *entity.Match) error {
-	query := `
-        INSERT INTO matches (
+// matchColumns lista as colunas de matches na ordem esperada por scanMatch e matchValues
+const matchColumns = `
             id, championship_id, home_team_id, away_team_id, match_date, status,
             score_home, score_away, has_extra_time, score_home_extra_time,
             score_away_extra_time, has_penalties, score_home_penalties,
-            score_away_penalties, winner_team_id, phase, parent_match_id,
-            left_child_match_id, right_child_match_id, created_at, updated_at
+            score_away_penalties, winner_team_id, phase, round, group_number, bracket,
+            parent_match_id, left_child_match_id, right_child_match_id, leg,
+            first_leg_match_id, loser_match_id, walkover, created_at, updated_at`
+
+const insertMatchQuery = `
+        INSERT INTO matches (` + matchColumns + `
         ) VALUES (
             $1, $2, $3, $4, $5, $6,
             $7, $8, $9, $10,
             $11, $12, $13,
-            $14, $15, $16, $17,
-            $18, $19, $20, $21
+            $14, $15, $16, $17, $18,
+            $19, $20, $21, $22, $23,
+            $24, $25, $26, $27, $28
         )
     `
-	_, err := r.pool.Exec(ctx, query,
+
+const updateMatchQuery = `
+        UPDATE matches SET
+            home_team_id = $1,
+            away_team_id = $2,
+            match_date = $3,
+            status = $4,
+            score_home = $5,
+            score_away = $6,
+            has_extra_time = $7,
+            score_home_extra_time = $8,
+            score_away_extra_time = $9,
+            has_penalties = $10,
+            score_home_penalties = $11,
+            score_away_penalties = $12,
+            winner_team_id = $13,
+            phase = $14,
+            round = $15,
+            group_number = $16,
+            bracket = $17,
+            parent_match_id = $18,
+            left_child_match_id = $19,
+            right_child_match_id = $20,
+            leg = $21,
+            first_leg_match_id = $22,
+            loser_match_id = $23,
+            walkover = $24,
+            updated_at = $25
+        WHERE id = $26
+    `
+
+type matchRepositoryPg struct {
+	pool *pgxpool.Pool
+}
+
+func NewMatchRepositoryPg(pool *pgxpool.Pool) repository.MatchRepository {
+	return &matchRepositoryPg{pool: pool}
+}
+
+func matchValues(match *entity.Match) []any {
+	return []any{
 		match.ID,
 		match.ChampionshipID,
 		match.HomeTeamID,
@@ -53,28 +89,53 @@ func (r *matchRepositoryPg) Create(ctx context.Context, match *entity.Match) err
 		match.ScoreAwayPenalties,
 		match.WinnerTeamID,
 		match.Phase,
+		match.Round,
+		match.GroupNumber,
+		match.Bracket,
 		match.ParentMatchID,
 		match.LeftChildMatchID,
 		match.RightChildMatchID,
+		match.Leg,
+		match.FirstLegMatchID,
+		match.LoserMatchID,
+		match.Walkover,
 		match.CreatedAt,
 		match.UpdatedAt,
-	)
-	return err
+	}
 }
 
-func (r *matchRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
-	query := `
-        SELECT
-            id, championship_id, home_team_id, away_team_id, match_date, status,
-            score_home, score_away, has_extra_time, score_home_extra_time,
-            score_away_extra_time, has_penalties, score_home_penalties,
-            score_away_penalties, winner_team_id, phase, parent_match_id,
-            left_child_match_id, right_child_match_id, created_at, updated_at
-        FROM matches
-        WHERE id = $1
-    `
-	row := r.pool.QueryRow(ctx, query, id)
+func matchUpdateValues(match *entity.Match) []any {
+	return []any{
+		match.HomeTeamID,
+		match.AwayTeamID,
+		match.MatchDate,
+		match.Status,
+		match.ScoreHome,
+		match.ScoreAway,
+		match.HasExtraTime,
+		match.ScoreHomeExtraTime,
+		match.ScoreAwayExtraTime,
+		match.HasPenalties,
+		match.ScoreHomePenalties,
+		match.ScoreAwayPenalties,
+		match.WinnerTeamID,
+		match.Phase,
+		match.Round,
+		match.GroupNumber,
+		match.Bracket,
+		match.ParentMatchID,
+		match.LeftChildMatchID,
+		match.RightChildMatchID,
+		match.Leg,
+		match.FirstLegMatchID,
+		match.LoserMatchID,
+		match.Walkover,
+		time.Now(),
+		match.ID,
+	}
+}
 
+func scanMatch(row pgx.Row) (*entity.Match, error) {
 	var match entity.Match
 	err := row.Scan(
 		&match.ID,
@@ -93,12 +154,56 @@ func (r *matchRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*entity.
 		&match.ScoreAwayPenalties,
 		&match.WinnerTeamID,
 		&match.Phase,
+		&match.Round,
+		&match.GroupNumber,
+		&match.Bracket,
 		&match.ParentMatchID,
 		&match.LeftChildMatchID,
 		&match.RightChildMatchID,
+		&match.Leg,
+		&match.FirstLegMatchID,
+		&match.LoserMatchID,
+		&match.Walkover,
 		&match.CreatedAt,
 		&match.UpdatedAt,
 	)
+	if err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
+
+func scanMatches(rows pgx.Rows) ([]*entity.Match, error) {
+	defer rows.Close()
+
+	var matches []*entity.Match
+	for rows.Next() {
+		match, err := scanMatch(rows)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+func (r *matchRepositoryPg) Create(ctx context.Context, match *entity.Match) error {
+	_, err := r.pool.Exec(ctx, insertMatchQuery, matchValues(match)...)
+	return err
+}
+
+func (r *matchRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*entity.Match, error) {
+	query := `
+        SELECT` + matchColumns + `
+        FROM matches
+        WHERE id = $1
+    `
+	match, err := scanMatch(r.pool.QueryRow(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil // Match não encontrado
@@ -106,53 +211,11 @@ func (r *matchRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*entity.
 		return nil, err
 	}
 
-	return &match, nil
+	return match, nil
 }
 
 func (r *matchRepositoryPg) Update(ctx context.Context, match *entity.Match) error {
-	query := `
-        UPDATE matches SET
-            home_team_id = $1,
-            away_team_id = $2,
-            match_date = $3,
-            status = $4,
-            score_home = $5,
-            score_away = $6,
-            has_extra_time = $7,
-            score_home_extra_time = $8,
-            score_away_extra_time = $9,
-            has_penalties = $10,
-            score_home_penalties = $11,
-            score_away_penalties = $12,
-            winner_team_id = $13,
-            phase = $14,
-            parent_match_id = $15,
-            left_child_match_id = $16,
-            right_child_match_id = $17,
-            updated_at = $18
-        WHERE id = $19
-    `
-	commandTag, err := r.pool.Exec(ctx, query,
-		match.HomeTeamID,
-		match.AwayTeamID,
-		match.MatchDate,
-		match.Status,
-		match.ScoreHome,
-		match.ScoreAway,
-		match.HasExtraTime,
-		match.ScoreHomeExtraTime,
-		match.ScoreAwayExtraTime,
-		match.HasPenalties,
-		match.ScoreHomePenalties,
-		match.ScoreAwayPenalties,
-		match.WinnerTeamID,
-		match.Phase,
-		match.ParentMatchID,
-		match.LeftChildMatchID,
-		match.RightChildMatchID,
-		time.Now(),
-		match.ID,
-	)
+	commandTag, err := r.pool.Exec(ctx, updateMatchQuery, matchUpdateValues(match)...)
 	if err != nil {
 		return err
 	}
@@ -183,65 +246,22 @@ func (r *matchRepositoryPg) Delete(ctx context.Context, id uuid.UUID) error {
 
 func (r *matchRepositoryPg) GetByChampionshipID(ctx context.Context, championshipID uuid.UUID) ([]*entity.Match, error) {
 	query := `
-        SELECT
-            id, championship_id, home_team_id, away_team_id, match_date, status,
-            score_home, score_away, has_extra_time, score_home_extra_time,
-            score_away_extra_time, has_penalties, score_home_penalties,
-            score_away_penalties, winner_team_id, phase, parent_match_id,
-            left_child_match_id, right_child_match_id, created_at, updated_at
+        SELECT` + matchColumns + `
         FROM matches
         WHERE championship_id = $1
-        ORDER BY phase ASC, match_date ASC
+        ORDER BY phase ASC, round ASC, match_date ASC
     `
 	rows, err := r.pool.Query(ctx, query, championshipID)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
-	var matches []*entity.Match
-	for rows.Next() {
-		var match entity.Match
-		err := rows.Scan(
-			&match.ID,
-			&match.ChampionshipID,
-			&match.HomeTeamID,
-			&match.AwayTeamID,
-			&match.MatchDate,
-			&match.Status,
-			&match.ScoreHome,
-			&match.ScoreAway,
-			&match.HasExtraTime,
-			&match.ScoreHomeExtraTime,
-			&match.ScoreAwayExtraTime,
-			&match.HasPenalties,
-			&match.ScoreHomePenalties,
-			&match.ScoreAwayPenalties,
-			&match.WinnerTeamID,
-			&match.Phase,
-			&match.ParentMatchID,
-			&match.LeftChildMatchID,
-			&match.RightChildMatchID,
-			&match.CreatedAt,
-			&match.UpdatedAt,
-		)
-		if err != nil {
-			return nil, err
-		}
-		matches = append(matches, &match)
-	}
-
-	return matches, nil
+	return scanMatches(rows)
 }
 
 func (r *matchRepositoryPg) GetByPhase(ctx context.Context, championshipID uuid.UUID, phase int) ([]*entity.Match, error) {
 	query := `
-        SELECT
-            id, championship_id, home_team_id, away_team_id, match_date, status,
-            score_home, score_away, has_extra_time, score_home_extra_time,
-            score_away_extra_time, has_penalties, score_home_penalties,
-            score_away_penalties, winner_team_id, phase, parent_match_id,
-            left_child_match_id, right_child_match_id, created_at, updated_at
+        SELECT` + matchColumns + `
         FROM matches
         WHERE championship_id = $1 AND phase = $2
         ORDER BY match_date ASC
@@ -250,41 +270,23 @@ func (r *matchRepositoryPg) GetByPhase(ctx context.Context, championshipID uuid.
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
-	var matches []*entity.Match
-	for rows.Next() {
-		var match entity.Match
-		err := rows.Scan(
-			&match.ID,
-			&match.ChampionshipID,
-			&match.HomeTeamID,
-			&match.AwayTeamID,
-			&match.MatchDate,
-			&match.Status,
-			&match.ScoreHome,
-			&match.ScoreAway,
-			&match.HasExtraTime,
-			&match.ScoreHomeExtraTime,
-			&match.ScoreAwayExtraTime,
-			&match.HasPenalties,
-			&match.ScoreHomePenalties,
-			&match.ScoreAwayPenalties,
-			&match.WinnerTeamID,
-			&match.Phase,
-			&match.ParentMatchID,
-			&match.LeftChildMatchID,
-			&match.RightChildMatchID,
-			&match.CreatedAt,
-			&match.UpdatedAt,
-		)
-		if err != nil {
-			return nil, err
-		}
-		matches = append(matches, &match)
+	return scanMatches(rows)
+}
+
+func (r *matchRepositoryPg) GetByRound(ctx context.Context, championshipID uuid.UUID, round int) ([]*entity.Match, error) {
+	query := `
+        SELECT` + matchColumns + `
+        FROM matches
+        WHERE championship_id = $1 AND round = $2
+        ORDER BY group_number ASC, match_date ASC
+    `
+	rows, err := r.pool.Query(ctx, query, championshipID, round)
+	if err != nil {
+		return nil, err
 	}
 
-	return matches, nil
+	return scanMatches(rows)
 }
 
 func (r *matchRepositoryPg) BeginTx(ctx context.Context) (pgx.Tx, error) {
@@ -292,134 +294,39 @@ func (r *matchRepositoryPg) BeginTx(ctx context.Context) (pgx.Tx, error) {
 }
 
 func (r *matchRepositoryPg) CreateWithTx(ctx context.Context, tx pgx.Tx, match *entity.Match) error {
-	query := `
-        INSERT INTO matches (
-            id, championship_id, home_team_id, away_team_id, match_date, status,
-            score_home, score_away, has_extra_time, score_home_extra_time, score_away_extra_time,
-            has_penalties, score_home_penalties, score_away_penalties, winner_team_id,
-            created_at, updated_at, phase, parent_match_id, left_child_match_id, right_child_match_id
-        )
-        VALUES (
-            $1, $2, $3, $4, $5, $6,
-            $7, $8, $9, $10, $11,
-            $12, $13, $14, $15,
-            $16, $17, $18, $19, $20, $21
-        )
-    `
-	_, err := tx.Exec(ctx, query,
-		match.ID,
-		match.ChampionshipID,
-		match.HomeTeamID,
-		match.AwayTeamID,
-		match.MatchDate,
-		match.Status,
-		match.ScoreHome,
-		match.ScoreAway,
-		match.HasExtraTime,
-		match.ScoreHomeExtraTime,
-		match.ScoreAwayExtraTime,
-		match.HasPenalties,
-		match.ScoreHomePenalties,
-		match.ScoreAwayPenalties,
-		match.WinnerTeamID,
-		match.CreatedAt,
-		match.UpdatedAt,
-		match.Phase,
-		match.ParentMatchID,
-		match.LeftChildMatchID,
-		match.RightChildMatchID,
-	)
+	_, err := tx.Exec(ctx, insertMatchQuery, matchValues(match)...)
 	return err
 }
 
 func (r *matchRepositoryPg) GetByIDWithTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*entity.Match, error) {
 	query := `
-		SELECT
-			id, championship_id, home_team_id, away_team_id, match_date, status,
-			score_home, score_away, has_extra_time, score_home_extra_time,
-			score_away_extra_time, has_penalties, score_home_penalties,
-			score_away_penalties, winner_team_id, phase, parent_match_id,
-			left_child_match_id, right_child_match_id, created_at, updated_at
+		SELECT` + matchColumns + `
 		FROM matches
 		WHERE id = $1
 	`
-	row := tx.QueryRow(ctx, query, id)
+	return scanMatch(tx.QueryRow(ctx, query, id))
+}
 
-	var match entity.Match
-	err := row.Scan(
-		&match.ID,
-		&match.ChampionshipID,
-		&match.HomeTeamID,
-		&match.AwayTeamID,
-		&match.MatchDate,
-		&match.Status,
-		&match.ScoreHome,
-		&match.ScoreAway,
-		&match.HasExtraTime,
-		&match.ScoreHomeExtraTime,
-		&match.ScoreAwayExtraTime,
-		&match.HasPenalties,
-		&match.ScoreHomePenalties,
-		&match.ScoreAwayPenalties,
-		&match.WinnerTeamID,
-		&match.Phase,
-		&match.ParentMatchID,
-		&match.LeftChildMatchID,
-		&match.RightChildMatchID,
-		&match.CreatedAt,
-		&match.UpdatedAt,
-	)
+// GetSecondLegWithTx devolve o jogo de volta do confronto iniciado em firstLegMatchID,
+// ou nil quando o confronto é de jogo único.
+func (r *matchRepositoryPg) GetSecondLegWithTx(ctx context.Context, tx pgx.Tx, firstLegMatchID uuid.UUID) (*entity.Match, error) {
+	query := `
+		SELECT` + matchColumns + `
+		FROM matches
+		WHERE first_leg_match_id = $1
+	`
+	match, err := scanMatch(tx.QueryRow(ctx, query, firstLegMatchID))
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
 		return nil, err
 	}
-
-	return &match, nil
+	return match, nil
 }
 
 func (r *matchRepositoryPg) UpdateWithTx(ctx context.Context, tx pgx.Tx, match *entity.Match) error {
-	query := `
-		UPDATE matches SET
-			home_team_id = $1,
-			away_team_id = $2,
-			match_date = $3,
-			status = $4,
-			score_home = $5,
-			score_away = $6,
-			has_extra_time = $7,
-			score_home_extra_time = $8,
-			score_away_extra_time = $9,
-			has_penalties = $10,
-			score_home_penalties = $11,
-			score_away_penalties = $12,
-			winner_team_id = $13,
-			phase = $14,
-			parent_match_id = $15,
-			left_child_match_id = $16,
-			right_child_match_id = $17,
-			updated_at = $18
-		WHERE id = $19
-	`
-	commandTag, err := tx.Exec(ctx, query,
-		match.HomeTeamID,
-		match.AwayTeamID,
-		match.MatchDate,
-		match.Status,
-		match.ScoreHome,
-		match.ScoreAway,
-		match.HasExtraTime,
-		match.ScoreHomeExtraTime,
-		match.ScoreAwayExtraTime,
-		match.HasPenalties,
-		match.ScoreHomePenalties,
-		match.ScoreAwayPenalties,
-		match.WinnerTeamID,
-		match.Phase,
-		match.ParentMatchID,
-		match.LeftChildMatchID,
-		match.RightChildMatchID,
-		time.Now(),
-		match.ID,
-	)
+	commandTag, err := tx.Exec(ctx, updateMatchQuery, matchUpdateValues(match)...)
 	if err != nil {
 		return err
 	}
@@ -430,3 +337,35 @@ func (r *matchRepositoryPg) UpdateWithTx(ctx context.Context, tx pgx.Tx, match *
 
 	return nil
 }
+
+func (r *matchRepositoryPg) GetByChampionshipIDWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) ([]*entity.Match, error) {
+	query := `
+        SELECT` + matchColumns + `
+        FROM matches
+        WHERE championship_id = $1
+        ORDER BY phase ASC, round ASC, match_date ASC
+    `
+	rows, err := tx.Query(ctx, query, championshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMatches(rows)
+}
+
+func (r *matchRepositoryPg) DeleteWithTx(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	query := `
+        DELETE FROM matches
+        WHERE id = $1
+    `
+	commandTag, err := tx.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() != 1 {
+		return errors.New("no rows were deleted")
+	}
+
+	return nil
+}
@@ -376,6 +376,65 @@ func TestMatchRepositoryPg_GetByPhase(t *testing.T) {
 	assert.Equal(t, match2.ID, matchesPhase2[0].ID)
 }
 
+func TestMatchRepositoryPg_GetByRound(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	matchRepo := NewMatchRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+
+	championshipId, err := createChampionship(uuid.New(), pool)
+	require.NoError(t, err)
+
+	homeTeamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	awayTeamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	// Criar o jogo de ida e o de volta em rodadas diferentes
+	match1 := &entity.Match{
+		ID:             uuid.New(),
+		ChampionshipID: championshipId,
+		HomeTeamID:     &homeTeamID,
+		AwayTeamID:     &awayTeamID,
+		Status:         entity.MatchStatusScheduled,
+		Phase:          1,
+		Round:          1,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	match2 := &entity.Match{
+		ID:             uuid.New(),
+		ChampionshipID: championshipId,
+		HomeTeamID:     &awayTeamID,
+		AwayTeamID:     &homeTeamID,
+		Status:         entity.MatchStatusScheduled,
+		Phase:          1,
+		Round:          2,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	err = matchRepo.Create(ctx, match1)
+	require.NoError(t, err)
+
+	err = matchRepo.Create(ctx, match2)
+	require.NoError(t, err)
+
+	// Recuperar os matches da rodada 2
+	matchesRound2, err := matchRepo.GetByRound(ctx, championshipId, 2)
+	require.NoError(t, err)
+	require.Len(t, matchesRound2, 1)
+	assert.Equal(t, match2.ID, matchesRound2[0].ID)
+	assert.Equal(t, 2, matchesRound2[0].Round)
+}
+
 func TestMatchRepositoryPg_Update_NonExistent(t *testing.T) {
 	pool := setupTestDB(t)
 	defer pool.Close()
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// playerColumns lista as colunas de players na ordem esperada por scanPlayer
+const playerColumns = `
+            id, team_id, name, shirt_number, position, date_of_birth, joined_at, left_at,
+            created_at, updated_at`
+
+type playerRepositoryPg struct {
+	pool *pgxpool.Pool
+}
+
+func NewPlayerRepositoryPg(pool *pgxpool.Pool) repository.PlayerRepository {
+	return &playerRepositoryPg{pool: pool}
+}
+
+func scanPlayer(row pgx.Row) (*entity.Player, error) {
+	var player entity.Player
+	err := row.Scan(
+		&player.ID,
+		&player.TeamID,
+		&player.Name,
+		&player.ShirtNumber,
+		&player.Position,
+		&player.DateOfBirth,
+		&player.JoinedAt,
+		&player.LeftAt,
+		&player.CreatedAt,
+		&player.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &player, nil
+}
+
+func scanPlayers(rows pgx.Rows) ([]*entity.Player, error) {
+	defer rows.Close()
+
+	var players []*entity.Player
+	for rows.Next() {
+		player, err := scanPlayer(rows)
+		if err != nil {
+			return nil, err
+		}
+		players = append(players, player)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return players, nil
+}
+
+func (r *playerRepositoryPg) Create(ctx context.Context, player *entity.Player) error {
+	query := `
+        INSERT INTO players (` + playerColumns + `
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    `
+	_, err := r.pool.Exec(ctx, query,
+		player.ID,
+		player.TeamID,
+		player.Name,
+		player.ShirtNumber,
+		player.Position,
+		player.DateOfBirth,
+		player.JoinedAt,
+		player.LeftAt,
+		player.CreatedAt,
+		player.UpdatedAt,
+	)
+	return err
+}
+
+func (r *playerRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*entity.Player, error) {
+	query := `
+        SELECT` + playerColumns + `
+        FROM players
+        WHERE id = $1
+    `
+	player, err := scanPlayer(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil // Jogador não encontrado
+		}
+		return nil, err
+	}
+
+	return player, nil
+}
+
+func (r *playerRepositoryPg) Update(ctx context.Context, player *entity.Player) error {
+	query := `
+        UPDATE players
+        SET name = $1,
+            shirt_number = $2,
+            position = $3,
+            date_of_birth = $4,
+            joined_at = $5,
+            left_at = $6,
+            updated_at = $7
+        WHERE id = $8
+    `
+	commandTag, err := r.pool.Exec(ctx, query,
+		player.Name,
+		player.ShirtNumber,
+		player.Position,
+		player.DateOfBirth,
+		player.JoinedAt,
+		player.LeftAt,
+		time.Now(),
+		player.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() != 1 {
+		return errors.New("no rows were updated")
+	}
+
+	return nil
+}
+
+func (r *playerRepositoryPg) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `
+        DELETE FROM players
+        WHERE id = $1
+    `
+	commandTag, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if commandTag.RowsAffected() != 1 {
+		return errors.New("no rows were deleted")
+	}
+
+	return nil
+}
+
+func (r *playerRepositoryPg) ListByTeamID(ctx context.Context, teamID uuid.UUID) ([]*entity.Player, error) {
+	query := `
+        SELECT` + playerColumns + `
+        FROM players
+        WHERE team_id = $1
+        ORDER BY shirt_number ASC, joined_at ASC
+    `
+	rows, err := r.pool.Query(ctx, query, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanPlayers(rows)
+}
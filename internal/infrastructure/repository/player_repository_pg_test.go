@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlayerRepositoryPg_CreateAndGetByID(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	playerRepo := NewPlayerRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+
+	teamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	birth := time.Date(1954, time.February, 19, 0, 0, 0, 0, time.UTC)
+	player := &entity.Player{
+		ID:          uuid.New(),
+		TeamID:      teamID,
+		Name:        "Sócrates",
+		ShirtNumber: 8,
+		Position:    entity.PlayerPositionMidfielder,
+		DateOfBirth: &birth,
+		JoinedAt:    time.Now(),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// Teste de criação
+	err = playerRepo.Create(ctx, player)
+	require.NoError(t, err)
+
+	// Teste de recuperação pelo ID
+	retrievedPlayer, err := playerRepo.GetByID(ctx, player.ID)
+	require.NoError(t, err)
+	require.NotNil(t, retrievedPlayer)
+
+	assert.Equal(t, player.Name, retrievedPlayer.Name)
+	assert.Equal(t, player.ShirtNumber, retrievedPlayer.ShirtNumber)
+	assert.Equal(t, player.Position, retrievedPlayer.Position)
+	require.NotNil(t, retrievedPlayer.DateOfBirth)
+	assert.True(t, birth.Equal(*retrievedPlayer.DateOfBirth))
+	assert.Nil(t, retrievedPlayer.LeftAt)
+}
+
+func TestPlayerRepositoryPg_UpdateAndListByTeamID(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	playerRepo := NewPlayerRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+
+	teamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	for _, number := range []int{10, 1} {
+		player := &entity.Player{
+			ID:          uuid.New(),
+			TeamID:      teamID,
+			Name:        "Jogador",
+			ShirtNumber: number,
+			Position:    entity.PlayerPositionForward,
+			JoinedAt:    time.Now(),
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		}
+		err = playerRepo.Create(ctx, player)
+		require.NoError(t, err)
+	}
+
+	players, err := playerRepo.ListByTeamID(ctx, teamID)
+	require.NoError(t, err)
+	require.Len(t, players, 2)
+	assert.Equal(t, 1, players[0].ShirtNumber)
+
+	// Dispensa do jogador
+	leftAt := time.Now().Add(time.Hour)
+	players[0].LeftAt = &leftAt
+	err = playerRepo.Update(ctx, players[0])
+	require.NoError(t, err)
+
+	updatedPlayer, err := playerRepo.GetByID(ctx, players[0].ID)
+	require.NoError(t, err)
+	require.NotNil(t, updatedPlayer.LeftAt)
+
+	err = playerRepo.Delete(ctx, players[1].ID)
+	require.NoError(t, err)
+
+	players, err = playerRepo.ListByTeamID(ctx, teamID)
+	require.NoError(t, err)
+	assert.Len(t, players, 1)
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type playerStatisticsRepositoryPg struct {
+	pool *pgxpool.Pool
+}
+
+func NewPlayerStatisticsRepositoryPg(pool *pgxpool.Pool) repository.PlayerStatisticsRepository {
+	return &playerStatisticsRepositoryPg{pool: pool}
+}
+
+func (r *playerStatisticsRepositoryPg) ListByChampionship(ctx context.Context, championshipID uuid.UUID) ([]*entity.PlayerStatistics, error) {
+	query := `
+        SELECT s.id, s.championship_id, s.player_id, s.team_id, p.name, s.matches_played,
+               s.minutes_played, s.goals, s.assists, s.yellow_cards, s.red_cards, s.clean_sheets,
+               s.created_at, s.updated_at
+        FROM player_statistics s
+        JOIN players p ON p.id = s.player_id
+        WHERE s.championship_id = $1
+    `
+	rows, err := r.pool.Query(ctx, query, championshipID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var statsList []*entity.PlayerStatistics
+	for rows.Next() {
+		var stats entity.PlayerStatistics
+		err := rows.Scan(
+			&stats.ID,
+			&stats.ChampionshipID,
+			&stats.PlayerID,
+			&stats.TeamID,
+			&stats.PlayerName,
+			&stats.MatchesPlayed,
+			&stats.MinutesPlayed,
+			&stats.Goals,
+			&stats.Assists,
+			&stats.YellowCards,
+			&stats.RedCards,
+			&stats.CleanSheets,
+			&stats.CreatedAt,
+			&stats.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		statsList = append(statsList, &stats)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return statsList, nil
+}
+
+func (r *playerStatisticsRepositoryPg) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.pool.Begin(ctx)
+}
+
+func (r *playerStatisticsRepositoryPg) CreateWithTx(ctx context.Context, tx pgx.Tx, stats *entity.PlayerStatistics) error {
+	query := `
+        INSERT INTO player_statistics (
+            id, championship_id, player_id, team_id, matches_played, minutes_played, goals,
+            assists, yellow_cards, red_cards, clean_sheets, created_at, updated_at
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+    `
+	_, err := tx.Exec(ctx, query,
+		stats.ID,
+		stats.ChampionshipID,
+		stats.PlayerID,
+		stats.TeamID,
+		stats.MatchesPlayed,
+		stats.MinutesPlayed,
+		stats.Goals,
+		stats.Assists,
+		stats.YellowCards,
+		stats.RedCards,
+		stats.CleanSheets,
+		stats.CreatedAt,
+		stats.UpdatedAt,
+	)
+	return err
+}
+
+func (r *playerStatisticsRepositoryPg) DeleteByChampionshipWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) error {
+	query := `
+        DELETE FROM player_statistics
+        WHERE championship_id = $1
+    `
+	_, err := tx.Exec(ctx, query, championshipID)
+	return err
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type standingsSnapshotRepositoryPg struct {
+	pool *pgxpool.Pool
+}
+
+func NewStandingsSnapshotRepositoryPg(pool *pgxpool.Pool) repository.StandingsSnapshotRepository {
+	return &standingsSnapshotRepositoryPg{pool: pool}
+}
+
+const standingsSnapshotColumns = `
+            championship_id, round, team_id, group_number, position, matches_played, wins, draws,
+            losses, goals_for, goals_against, goal_difference, points, created_at`
+
+// standingsSnapshotOrder lista as rodadas em ordem e, em cada uma, os times pela posição,
+// grupo a grupo.
+const standingsSnapshotOrder = `
+        ORDER BY round ASC, group_number ASC NULLS FIRST, position ASC`
+
+func (r *standingsSnapshotRepositoryPg) ListByChampionship(ctx context.Context, championshipID uuid.UUID) ([]*entity.StandingsSnapshot, error) {
+	query := `
+        SELECT` + standingsSnapshotColumns + `
+        FROM standings_snapshots
+        WHERE championship_id = $1` + standingsSnapshotOrder
+	rows, err := r.pool.Query(ctx, query, championshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanStandingsSnapshots(rows)
+}
+
+func (r *standingsSnapshotRepositoryPg) ListByChampionshipAndRound(ctx context.Context, championshipID uuid.UUID, round int) ([]*entity.StandingsSnapshot, error) {
+	query := `
+        SELECT` + standingsSnapshotColumns + `
+        FROM standings_snapshots
+        WHERE championship_id = $1 AND round = $2` + standingsSnapshotOrder
+	rows, err := r.pool.Query(ctx, query, championshipID, round)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanStandingsSnapshots(rows)
+}
+
+func (r *standingsSnapshotRepositoryPg) ListByChampionshipAndTeam(ctx context.Context, championshipID, teamID uuid.UUID) ([]*entity.StandingsSnapshot, error) {
+	query := `
+        SELECT` + standingsSnapshotColumns + `
+        FROM standings_snapshots
+        WHERE championship_id = $1 AND team_id = $2` + standingsSnapshotOrder
+	rows, err := r.pool.Query(ctx, query, championshipID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanStandingsSnapshots(rows)
+}
+
+func (r *standingsSnapshotRepositoryPg) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.pool.Begin(ctx)
+}
+
+func (r *standingsSnapshotRepositoryPg) CreateWithTx(ctx context.Context, tx pgx.Tx, snapshot *entity.StandingsSnapshot) error {
+	query := `
+        INSERT INTO standings_snapshots (` + standingsSnapshotColumns + `
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+    `
+	_, err := tx.Exec(ctx, query,
+		snapshot.ChampionshipID,
+		snapshot.Round,
+		snapshot.TeamID,
+		snapshot.GroupNumber,
+		snapshot.Position,
+		snapshot.MatchesPlayed,
+		snapshot.Wins,
+		snapshot.Draws,
+		snapshot.Losses,
+		snapshot.GoalsFor,
+		snapshot.GoalsAgainst,
+		snapshot.GoalDifference,
+		snapshot.Points,
+		snapshot.CreatedAt,
+	)
+	return err
+}
+
+func (r *standingsSnapshotRepositoryPg) DeleteByChampionshipWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) error {
+	query := `
+        DELETE FROM standings_snapshots
+        WHERE championship_id = $1
+    `
+	_, err := tx.Exec(ctx, query, championshipID)
+	return err
+}
+
+func (r *standingsSnapshotRepositoryPg) DeleteFromRoundWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID, fromRound int) error {
+	query := `
+        DELETE FROM standings_snapshots
+        WHERE championship_id = $1 AND round >= $2
+    `
+	_, err := tx.Exec(ctx, query, championshipID, fromRound)
+	return err
+}
+
+func scanStandingsSnapshots(rows pgx.Rows) ([]*entity.StandingsSnapshot, error) {
+	defer rows.Close()
+
+	var snapshots []*entity.StandingsSnapshot
+	for rows.Next() {
+		var snapshot entity.StandingsSnapshot
+		err := rows.Scan(
+			&snapshot.ChampionshipID,
+			&snapshot.Round,
+			&snapshot.TeamID,
+			&snapshot.GroupNumber,
+			&snapshot.Position,
+			&snapshot.MatchesPlayed,
+			&snapshot.Wins,
+			&snapshot.Draws,
+			&snapshot.Losses,
+			&snapshot.GoalsFor,
+			&snapshot.GoalsAgainst,
+			&snapshot.GoalDifference,
+			&snapshot.Points,
+			&snapshot.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, &snapshot)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
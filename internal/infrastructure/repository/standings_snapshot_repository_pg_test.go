@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStandingsSnapshotRepositoryPg_CreateListAndDelete(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	snapshotRepo := NewStandingsSnapshotRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+
+	championshipID, err := createChampionship(uuid.New(), pool)
+	require.NoError(t, err)
+
+	team1, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	team2, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	snapshots := []*entity.StandingsSnapshot{
+		{ChampionshipID: championshipID, Round: 2, TeamID: team1, Position: 2, MatchesPlayed: 2, Wins: 1, Losses: 1, Points: 3, CreatedAt: time.Now()},
+		{ChampionshipID: championshipID, Round: 1, TeamID: team2, Position: 2, MatchesPlayed: 1, Losses: 1, CreatedAt: time.Now()},
+		{ChampionshipID: championshipID, Round: 1, TeamID: team1, Position: 1, MatchesPlayed: 1, Wins: 1, Points: 3, CreatedAt: time.Now()},
+		{ChampionshipID: championshipID, Round: 2, TeamID: team2, Position: 1, MatchesPlayed: 2, Wins: 1, Losses: 1, Points: 3, CreatedAt: time.Now()},
+	}
+
+	tx, err := snapshotRepo.BeginTx(ctx)
+	require.NoError(t, err)
+	for _, snapshot := range snapshots {
+		err = snapshotRepo.CreateWithTx(ctx, tx, snapshot)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tx.Commit(ctx))
+
+	all, err := snapshotRepo.ListByChampionship(ctx, championshipID)
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+	assert.Equal(t, 1, all[0].Round)
+	assert.Equal(t, team1, all[0].TeamID)
+	assert.Equal(t, team2, all[2].TeamID, "Na rodada 2 o time 2 passou à frente")
+
+	round1, err := snapshotRepo.ListByChampionshipAndRound(ctx, championshipID, 1)
+	require.NoError(t, err)
+	require.Len(t, round1, 2)
+	assert.Equal(t, 1, round1[0].Position)
+
+	positions, err := snapshotRepo.ListByChampionshipAndTeam(ctx, championshipID, team1)
+	require.NoError(t, err)
+	require.Len(t, positions, 2)
+	assert.Equal(t, 1, positions[0].Position)
+	assert.Equal(t, 2, positions[1].Position)
+
+	tx, err = snapshotRepo.BeginTx(ctx)
+	require.NoError(t, err)
+	err = snapshotRepo.DeleteByChampionshipWithTx(ctx, tx, championshipID)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit(ctx))
+
+	all, err = snapshotRepo.ListByChampionship(ctx, championshipID)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
@@ -12,6 +12,43 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// statisticsColumns lista as colunas de statistics na ordem esperada por scanStatistics e statisticsValues
+const statisticsColumns = `
+            id, championship_id, team_id, group_number, matches_played, wins, draws, losses,
+            goals_for, goals_against, goal_difference, points, disqualified, created_at, updated_at`
+
+const insertStatisticsQuery = `
+        INSERT INTO statistics (` + statisticsColumns + `
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8,
+                $9, $10, $11, $12, $13, $14, $15)
+    `
+
+const insertStatisticsIfNotExistsQuery = `
+        INSERT INTO statistics (` + statisticsColumns + `
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8,
+                $9, $10, $11, $12, $13, $14, $15)
+        ON CONFLICT (championship_id, team_id) DO NOTHING
+    `
+
+const updateStatisticsQuery = `
+        UPDATE statistics
+        SET
+            group_number = $1,
+            matches_played = $2,
+            wins = $3,
+            draws = $4,
+            losses = $5,
+            goals_for = $6,
+            goals_against = $7,
+            goal_difference = $8,
+            points = $9,
+            disqualified = $10,
+            updated_at = $11
+        WHERE id = $12
+    `
+
 type statisticsRepositoryPg struct {
 	pool *pgxpool.Pool
 }
@@ -20,19 +57,12 @@ func NewStatisticsRepositoryPg(pool *pgxpool.Pool) repository.StatisticsReposito
 	return &statisticsRepositoryPg{pool: pool}
 }
 
-func (r *statisticsRepositoryPg) Create(ctx context.Context, stats *entity.Statistics) error {
-	query := `
-        INSERT INTO statistics (
-            id, championship_id, team_id, matches_played, wins, draws, losses,
-            goals_for, goals_against, goal_difference, points, created_at, updated_at
-        )
-        VALUES ($1, $2, $3, $4, $5, $6, $7,
-                $8, $9, $10, $11, $12, $13)
-    `
-	_, err := r.pool.Exec(ctx, query,
+func statisticsValues(stats *entity.Statistics) []any {
+	return []any{
 		stats.ID,
 		stats.ChampionshipID,
 		stats.TeamID,
+		stats.GroupNumber,
 		stats.MatchesPlayed,
 		stats.Wins,
 		stats.Draws,
@@ -41,27 +71,36 @@ func (r *statisticsRepositoryPg) Create(ctx context.Context, stats *entity.Stati
 		stats.GoalsAgainst,
 		stats.GoalDifference,
 		stats.Points,
+		stats.Disqualified,
 		stats.CreatedAt,
 		stats.UpdatedAt,
-	)
-	return err
+	}
 }
 
-func (r *statisticsRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*entity.Statistics, error) {
-	query := `
-        SELECT
-            id, championship_id, team_id, matches_played, wins, draws, losses,
-            goals_for, goals_against, goal_difference, points, created_at, updated_at
-        FROM statistics
-        WHERE id = $1
-    `
-	row := r.pool.QueryRow(ctx, query, id)
+func statisticsUpdateValues(stats *entity.Statistics) []any {
+	return []any{
+		stats.GroupNumber,
+		stats.MatchesPlayed,
+		stats.Wins,
+		stats.Draws,
+		stats.Losses,
+		stats.GoalsFor,
+		stats.GoalsAgainst,
+		stats.GoalDifference,
+		stats.Points,
+		stats.Disqualified,
+		time.Now(),
+		stats.ID,
+	}
+}
 
+func scanStatistics(row pgx.Row) (*entity.Statistics, error) {
 	var stats entity.Statistics
 	err := row.Scan(
 		&stats.ID,
 		&stats.ChampionshipID,
 		&stats.TeamID,
+		&stats.GroupNumber,
 		&stats.MatchesPlayed,
 		&stats.Wins,
 		&stats.Draws,
@@ -70,6 +109,7 @@ func (r *statisticsRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*en
 		&stats.GoalsAgainst,
 		&stats.GoalDifference,
 		&stats.Points,
+		&stats.Disqualified,
 		&stats.CreatedAt,
 		&stats.UpdatedAt,
 	)
@@ -79,73 +119,53 @@ func (r *statisticsRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*en
 		}
 		return nil, err
 	}
-
 	return &stats, nil
 }
 
-func (r *statisticsRepositoryPg) GetByChampionshipAndTeam(ctx context.Context, championshipID, teamID uuid.UUID) (*entity.Statistics, error) {
-	query := `
-        SELECT
-            id, championship_id, team_id, matches_played, wins, draws, losses,
-            goals_for, goals_against, goal_difference, points, created_at, updated_at
-        FROM statistics
-        WHERE championship_id = $1 AND team_id = $2
-    `
-	row := r.pool.QueryRow(ctx, query, championshipID, teamID)
+func scanStatisticsList(rows pgx.Rows) ([]*entity.Statistics, error) {
+	defer rows.Close()
 
-	var stats entity.Statistics
-	err := row.Scan(
-		&stats.ID,
-		&stats.ChampionshipID,
-		&stats.TeamID,
-		&stats.MatchesPlayed,
-		&stats.Wins,
-		&stats.Draws,
-		&stats.Losses,
-		&stats.GoalsFor,
-		&stats.GoalsAgainst,
-		&stats.GoalDifference,
-		&stats.Points,
-		&stats.CreatedAt,
-		&stats.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil // Estatística não encontrada
+	var statsList []*entity.Statistics
+	for rows.Next() {
+		stats, err := scanStatistics(rows)
+		if err != nil {
+			return nil, err
 		}
+		statsList = append(statsList, stats)
+	}
+
+	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return &stats, nil
+	return statsList, nil
 }
 
-func (r *statisticsRepositoryPg) Update(ctx context.Context, stats *entity.Statistics) error {
+func (r *statisticsRepositoryPg) Create(ctx context.Context, stats *entity.Statistics) error {
+	_, err := r.pool.Exec(ctx, insertStatisticsQuery, statisticsValues(stats)...)
+	return err
+}
+
+func (r *statisticsRepositoryPg) GetByID(ctx context.Context, id uuid.UUID) (*entity.Statistics, error) {
 	query := `
-        UPDATE statistics
-        SET
-            matches_played = $1,
-            wins = $2,
-            draws = $3,
-            losses = $4,
-            goals_for = $5,
-            goals_against = $6,
-            goal_difference = $7,
-            points = $8,
-            updated_at = $9
-        WHERE id = $10
+        SELECT` + statisticsColumns + `
+        FROM statistics
+        WHERE id = $1
     `
-	commandTag, err := r.pool.Exec(ctx, query,
-		stats.MatchesPlayed,
-		stats.Wins,
-		stats.Draws,
-		stats.Losses,
-		stats.GoalsFor,
-		stats.GoalsAgainst,
-		stats.GoalDifference,
-		stats.Points,
-		time.Now(),
-		stats.ID,
-	)
+	return scanStatistics(r.pool.QueryRow(ctx, query, id))
+}
+
+func (r *statisticsRepositoryPg) GetByChampionshipAndTeam(ctx context.Context, championshipID, teamID uuid.UUID) (*entity.Statistics, error) {
+	query := `
+        SELECT` + statisticsColumns + `
+        FROM statistics
+        WHERE championship_id = $1 AND team_id = $2
+    `
+	return scanStatistics(r.pool.QueryRow(ctx, query, championshipID, teamID))
+}
+
+func (r *statisticsRepositoryPg) Update(ctx context.Context, stats *entity.Statistics) error {
+	commandTag, err := r.pool.Exec(ctx, updateStatisticsQuery, statisticsUpdateValues(stats)...)
 	if err != nil {
 		return err
 	}
@@ -176,9 +196,7 @@ func (r *statisticsRepositoryPg) Delete(ctx context.Context, id uuid.UUID) error
 
 func (r *statisticsRepositoryPg) ListByChampionship(ctx context.Context, championshipID uuid.UUID) ([]*entity.Statistics, error) {
 	query := `
-        SELECT
-            id, championship_id, team_id, matches_played, wins, draws, losses,
-            goals_for, goals_against, goal_difference, points, created_at, updated_at
+        SELECT` + statisticsColumns + `
         FROM statistics
         WHERE championship_id = $1
         ORDER BY points DESC, goal_difference DESC, goals_for DESC
@@ -187,38 +205,23 @@ func (r *statisticsRepositoryPg) ListByChampionship(ctx context.Context, champio
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-
-	var statsList []*entity.Statistics
 
-	for rows.Next() {
-		var stats entity.Statistics
-		err := rows.Scan(
-			&stats.ID,
-			&stats.ChampionshipID,
-			&stats.TeamID,
-			&stats.MatchesPlayed,
-			&stats.Wins,
-			&stats.Draws,
-			&stats.Losses,
-			&stats.GoalsFor,
-			&stats.GoalsAgainst,
-			&stats.GoalDifference,
-			&stats.Points,
-			&stats.CreatedAt,
-			&stats.UpdatedAt,
-		)
-		if err != nil {
-			return nil, err
-		}
-		statsList = append(statsList, &stats)
-	}
+	return scanStatisticsList(rows)
+}
 
-	if err = rows.Err(); err != nil {
+func (r *statisticsRepositoryPg) ListByChampionshipAndGroup(ctx context.Context, championshipID uuid.UUID, groupNumber int) ([]*entity.Statistics, error) {
+	query := `
+        SELECT` + statisticsColumns + `
+        FROM statistics
+        WHERE championship_id = $1 AND group_number = $2
+        ORDER BY points DESC, goal_difference DESC, goals_for DESC
+    `
+	rows, err := r.pool.Query(ctx, query, championshipID, groupNumber)
+	if err != nil {
 		return nil, err
 	}
 
-	return statsList, nil
+	return scanStatisticsList(rows)
 }
 
 func (r *statisticsRepositoryPg) BeginTx(ctx context.Context) (pgx.Tx, error) {
@@ -226,95 +229,28 @@ func (r *statisticsRepositoryPg) BeginTx(ctx context.Context) (pgx.Tx, error) {
 }
 
 func (r *statisticsRepositoryPg) CreateWithTx(ctx context.Context, tx pgx.Tx, stats *entity.Statistics) error {
-	query := `
-        INSERT INTO statistics (
-            id, championship_id, team_id, matches_played, wins, draws, losses,
-            goals_for, goals_against, goal_difference, points, created_at, updated_at
-        )
-        VALUES ($1, $2, $3, $4, $5, $6, $7,
-                $8, $9, $10, $11, $12, $13)
-    `
-	_, err := tx.Exec(ctx, query,
-		stats.ID,
-		stats.ChampionshipID,
-		stats.TeamID,
-		stats.MatchesPlayed,
-		stats.Wins,
-		stats.Draws,
-		stats.Losses,
-		stats.GoalsFor,
-		stats.GoalsAgainst,
-		stats.GoalDifference,
-		stats.Points,
-		stats.CreatedAt,
-		stats.UpdatedAt,
-	)
+	_, err := tx.Exec(ctx, insertStatisticsQuery, statisticsValues(stats)...)
+	return err
+}
+
+// CreateIfNotExistsWithTx grava as estatísticas apenas se o time ainda não tiver estatísticas no
+// campeonato.
+func (r *statisticsRepositoryPg) CreateIfNotExistsWithTx(ctx context.Context, tx pgx.Tx, stats *entity.Statistics) error {
+	_, err := tx.Exec(ctx, insertStatisticsIfNotExistsQuery, statisticsValues(stats)...)
 	return err
 }
 
 func (r *statisticsRepositoryPg) GetByChampionshipAndTeamWithTx(ctx context.Context, tx pgx.Tx, championshipID, teamID uuid.UUID) (*entity.Statistics, error) {
 	query := `
-        SELECT
-            id, championship_id, team_id, matches_played, wins, draws, losses,
-            goals_for, goals_against, goal_difference, points, created_at, updated_at
+        SELECT` + statisticsColumns + `
         FROM statistics
         WHERE championship_id = $1 AND team_id = $2
     `
-	row := tx.QueryRow(ctx, query, championshipID, teamID)
-
-	var stats entity.Statistics
-	err := row.Scan(
-		&stats.ID,
-		&stats.ChampionshipID,
-		&stats.TeamID,
-		&stats.MatchesPlayed,
-		&stats.Wins,
-		&stats.Draws,
-		&stats.Losses,
-		&stats.GoalsFor,
-		&stats.GoalsAgainst,
-		&stats.GoalDifference,
-		&stats.Points,
-		&stats.CreatedAt,
-		&stats.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil // Estatística não encontrada
-		}
-		return nil, err
-	}
-
-	return &stats, nil
+	return scanStatistics(tx.QueryRow(ctx, query, championshipID, teamID))
 }
 
 func (r *statisticsRepositoryPg) UpdateWithTx(ctx context.Context, tx pgx.Tx, stats *entity.Statistics) error {
-	query := `
-        UPDATE statistics
-        SET
-            matches_played = $1,
-            wins = $2,
-            draws = $3,
-            losses = $4,
-            goals_for = $5,
-            goals_against = $6,
-            goal_difference = $7,
-            points = $8,
-            updated_at = $9
-        WHERE id = $10
-    `
-	commandTag, err := tx.Exec(ctx, query,
-		stats.MatchesPlayed,
-		stats.Wins,
-		stats.Draws,
-		stats.Losses,
-		stats.GoalsFor,
-		stats.GoalsAgainst,
-		stats.GoalDifference,
-		stats.Points,
-		time.Now(),
-		stats.ID,
-	)
+	commandTag, err := tx.Exec(ctx, updateStatisticsQuery, statisticsUpdateValues(stats)...)
 	if err != nil {
 		return err
 	}
@@ -325,3 +261,18 @@ func (r *statisticsRepositoryPg) UpdateWithTx(ctx context.Context, tx pgx.Tx, st
 
 	return nil
 }
+
+func (r *statisticsRepositoryPg) ListByChampionshipWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) ([]*entity.Statistics, error) {
+	query := `
+        SELECT` + statisticsColumns + `
+        FROM statistics
+        WHERE championship_id = $1
+        FOR UPDATE
+    `
+	rows, err := tx.Query(ctx, query, championshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanStatisticsList(rows)
+}
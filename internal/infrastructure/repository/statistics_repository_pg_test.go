@@ -283,3 +283,150 @@ func TestStatisticsRepositoryPg_ListByChampionship(t *testing.T) {
 	assert.Equal(t, stats1.ID, statsList[0].ID)
 	assert.Equal(t, stats2.ID, statsList[1].ID)
 }
+
+func TestStatisticsRepositoryPg_ListByChampionshipAndGroup(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	statsRepo := NewStatisticsRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+
+	team1, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	team2, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	championshipId, err := createChampionship(uuid.New(), pool)
+	require.NoError(t, err)
+
+	groupA := 1
+	groupB := 2
+
+	// Criar estatísticas em grupos diferentes
+	stats1 := &entity.Statistics{
+		ID:             uuid.New(),
+		ChampionshipID: championshipId,
+		TeamID:         team1,
+		GroupNumber:    &groupA,
+		Points:         3,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	stats2 := &entity.Statistics{
+		ID:             uuid.New(),
+		ChampionshipID: championshipId,
+		TeamID:         team2,
+		GroupNumber:    &groupB,
+		Points:         1,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	err = statsRepo.Create(ctx, stats1)
+	require.NoError(t, err)
+
+	err = statsRepo.Create(ctx, stats2)
+	require.NoError(t, err)
+
+	// Listar apenas o grupo B
+	statsList, err := statsRepo.ListByChampionshipAndGroup(ctx, championshipId, groupB)
+	require.NoError(t, err)
+	require.Len(t, statsList, 1)
+
+	assert.Equal(t, stats2.ID, statsList[0].ID)
+	require.NotNil(t, statsList[0].GroupNumber)
+	assert.Equal(t, groupB, *statsList[0].GroupNumber)
+}
+
+func TestStatisticsRepositoryPg_ListByChampionshipWithTx(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	statsRepo := NewStatisticsRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+
+	teamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	championshipId, err := createChampionship(uuid.New(), pool)
+	require.NoError(t, err)
+
+	stats := &entity.Statistics{
+		ID:             uuid.New(),
+		ChampionshipID: championshipId,
+		TeamID:         teamID,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	err = statsRepo.Create(ctx, stats)
+	require.NoError(t, err)
+
+	tx, err := statsRepo.BeginTx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx)
+
+	statsList, err := statsRepo.ListByChampionshipWithTx(ctx, tx, championshipId)
+	require.NoError(t, err)
+	require.Len(t, statsList, 1)
+	assert.Equal(t, stats.ID, statsList[0].ID)
+}
+
+func TestStatisticsRepositoryPg_CreateIfNotExistsWithTx(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	statsRepo := NewStatisticsRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+
+	teamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	championshipId, err := createChampionship(uuid.New(), pool)
+	require.NoError(t, err)
+
+	stats := &entity.Statistics{
+		ID:             uuid.New(),
+		ChampionshipID: championshipId,
+		TeamID:         teamID,
+		MatchesPlayed:  1,
+		Wins:           1,
+		Points:         3,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	err = statsRepo.Create(ctx, stats)
+	require.NoError(t, err)
+
+	// Um segundo registro do mesmo time é ignorado
+	tx, err := statsRepo.BeginTx(ctx)
+	require.NoError(t, err)
+	err = statsRepo.CreateIfNotExistsWithTx(ctx, tx, &entity.Statistics{
+		ID:             uuid.New(),
+		ChampionshipID: championshipId,
+		TeamID:         teamID,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit(ctx))
+
+	statsList, err := statsRepo.ListByChampionship(ctx, championshipId)
+	require.NoError(t, err)
+	require.Len(t, statsList, 1)
+	assert.Equal(t, stats.ID, statsList[0].ID)
+	assert.Equal(t, 3, statsList[0].Points)
+}
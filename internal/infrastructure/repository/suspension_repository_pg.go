@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/domain/repository"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type suspensionRepositoryPg struct {
+	pool *pgxpool.Pool
+}
+
+func NewSuspensionRepositoryPg(pool *pgxpool.Pool) repository.SuspensionRepository {
+	return &suspensionRepositoryPg{pool: pool}
+}
+
+func (r *suspensionRepositoryPg) ListByChampionship(ctx context.Context, championshipID uuid.UUID) ([]*entity.Suspension, error) {
+	query := `
+        SELECT s.id, s.championship_id, s.player_id, s.team_id, s.match_id, p.name, s.reason,
+               s.matches, s.served_match_ids, s.created_at, s.updated_at
+        FROM suspensions s
+        JOIN players p ON p.id = s.player_id
+        WHERE s.championship_id = $1
+        ORDER BY s.created_at ASC
+    `
+	rows, err := r.pool.Query(ctx, query, championshipID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var suspensions []*entity.Suspension
+	for rows.Next() {
+		var suspension entity.Suspension
+		var reasonStr string
+		err := rows.Scan(
+			&suspension.ID,
+			&suspension.ChampionshipID,
+			&suspension.PlayerID,
+			&suspension.TeamID,
+			&suspension.MatchID,
+			&suspension.PlayerName,
+			&reasonStr,
+			&suspension.Matches,
+			&suspension.ServedMatchIDs,
+			&suspension.CreatedAt,
+			&suspension.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		suspension.Reason = entity.SuspensionReason(reasonStr)
+		suspensions = append(suspensions, &suspension)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return suspensions, nil
+}
+
+func (r *suspensionRepositoryPg) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.pool.Begin(ctx)
+}
+
+func (r *suspensionRepositoryPg) CreateWithTx(ctx context.Context, tx pgx.Tx, suspension *entity.Suspension) error {
+	query := `
+        INSERT INTO suspensions (
+            id, championship_id, player_id, team_id, match_id, reason, matches, served_match_ids,
+            created_at, updated_at
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    `
+	// served_match_ids não aceita NULL
+	servedMatchIDs := suspension.ServedMatchIDs
+	if servedMatchIDs == nil {
+		servedMatchIDs = []uuid.UUID{}
+	}
+
+	_, err := tx.Exec(ctx, query,
+		suspension.ID,
+		suspension.ChampionshipID,
+		suspension.PlayerID,
+		suspension.TeamID,
+		suspension.MatchID,
+		string(suspension.Reason),
+		suspension.Matches,
+		servedMatchIDs,
+		suspension.CreatedAt,
+		suspension.UpdatedAt,
+	)
+	return err
+}
+
+func (r *suspensionRepositoryPg) DeleteByChampionshipWithTx(ctx context.Context, tx pgx.Tx, championshipID uuid.UUID) error {
+	query := `
+        DELETE FROM suspensions
+        WHERE championship_id = $1
+    `
+	_, err := tx.Exec(ctx, query, championshipID)
+	return err
+}
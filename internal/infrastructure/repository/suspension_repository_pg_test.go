@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"champi-maker/internal/domain/entity"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuspensionRepositoryPg_CreateListAndDelete(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	matchRepo := NewMatchRepositoryPg(pool)
+	playerRepo := NewPlayerRepositoryPg(pool)
+	suspensionRepo := NewSuspensionRepositoryPg(pool)
+
+	userID, err := createUser(uuid.New(), pool)
+	require.NoError(t, err)
+
+	championshipID, err := createChampionship(uuid.New(), pool)
+	require.NoError(t, err)
+
+	homeTeamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	awayTeamID, err := createTeam(uuid.New(), userID, pool)
+	require.NoError(t, err)
+
+	match := &entity.Match{
+		ID:             uuid.New(),
+		ChampionshipID: championshipID,
+		HomeTeamID:     &homeTeamID,
+		AwayTeamID:     &awayTeamID,
+		Status:         entity.MatchStatusFinished,
+		Phase:          1,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	err = matchRepo.Create(ctx, match)
+	require.NoError(t, err)
+
+	player := &entity.Player{
+		ID:          uuid.New(),
+		TeamID:      homeTeamID,
+		Name:        "Jogador",
+		ShirtNumber: 5,
+		Position:    entity.PlayerPositionDefender,
+		JoinedAt:    time.Now(),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	err = playerRepo.Create(ctx, player)
+	require.NoError(t, err)
+
+	servedMatchID := uuid.New()
+	suspension := &entity.Suspension{
+		ID:             uuid.New(),
+		ChampionshipID: championshipID,
+		PlayerID:       player.ID,
+		TeamID:         homeTeamID,
+		MatchID:        match.ID,
+		Reason:         entity.SuspensionRedCard,
+		Matches:        2,
+		ServedMatchIDs: []uuid.UUID{servedMatchID},
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	tx, err := suspensionRepo.BeginTx(ctx)
+	require.NoError(t, err)
+	err = suspensionRepo.CreateWithTx(ctx, tx, suspension)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit(ctx))
+
+	suspensions, err := suspensionRepo.ListByChampionship(ctx, championshipID)
+	require.NoError(t, err)
+	require.Len(t, suspensions, 1)
+	assert.Equal(t, player.Name, suspensions[0].PlayerName)
+	assert.Equal(t, entity.SuspensionRedCard, suspensions[0].Reason)
+	assert.Equal(t, []uuid.UUID{servedMatchID}, suspensions[0].ServedMatchIDs)
+
+	tx, err = suspensionRepo.BeginTx(ctx)
+	require.NoError(t, err)
+	err = suspensionRepo.DeleteByChampionshipWithTx(ctx, tx, championshipID)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit(ctx))
+
+	suspensions, err = suspensionRepo.ListByChampionship(ctx, championshipID)
+	require.NoError(t, err)
+	assert.Empty(t, suspensions)
+}
@@ -20,18 +20,55 @@ func NewChampionshipHandler(service service.ChampionshipService) *ChampionshipHa
 }
 
 type CreateChampionshipRequest struct {
-	Name             string                  `json:"name" binding:"required"`
-	Type             entity.ChampionshipType `json:"type" binding:"required,oneof=league cup"`
-	TiebreakerMethod entity.TiebreakerMethod `json:"tiebreaker_method" validate:"required,oneof=penalties extra_time"`
-	ProgressionType  entity.ProgressionType  `json:"progression_type" validate:"required,oneof=fixed random_draw"`
-	TeamIDs          []uuid.UUID             `json:"team_ids" binding:"required,min=2"`
+	Name                 string                      `json:"name" binding:"required"`
+	Type                 entity.ChampionshipType     `json:"type" binding:"required,oneof=league cup groups_knockout swiss"`
+	TiebreakerMethod     entity.TiebreakerMethod     `json:"tiebreaker_method" validate:"required,oneof=penalties extra_time"`
+	ProgressionType      entity.ProgressionType      `json:"progression_type" validate:"required,oneof=fixed random_draw"`
+	NumGroups            int                         `json:"num_groups" binding:"gte=0"`
+	QualifiersPerGroup   int                         `json:"qualifiers_per_group" binding:"gte=0"`
+	Legs                 int                         `json:"legs" binding:"omitempty,oneof=1 2 4"`
+	SwissRounds          int                         `json:"swiss_rounds" binding:"gte=0"`
+	DoubleElimination    bool                        `json:"double_elimination"`
+	BracketReset         bool                        `json:"bracket_reset"`
+	ThirdPlaceMatch      bool                        `json:"third_place_match"`
+	TwoLeggedTies        bool                        `json:"two_legged_ties"`
+	TwoLeggedPhases      []int                       `json:"two_legged_phases" binding:"dive,gte=1"`
+	AwayGoals            bool                        `json:"away_goals"`
+	PointsSystem         *entity.PointsSystem        `json:"points_system"`
+	Tiebreakers          []entity.StandingsCriterion `json:"tiebreakers" binding:"dive,oneof=goal_difference goals_for goals_against wins away_goals head_to_head head_to_head_points head_to_head_goal_difference drawing_of_lots"`
+	DisqualificationRule entity.DisqualificationRule `json:"disqualification_rule" binding:"omitempty,oneof=void award"`
+	DisciplinaryRules    *entity.DisciplinaryRules   `json:"disciplinary_rules"`
+	SquadRules           *entity.SquadRules          `json:"squad_rules"`
+	Seeds                []uuid.UUID                 `json:"seeds"`
+	DrawSeed             int64                       `json:"draw_seed"`
+	TeamIDs              []uuid.UUID                 `json:"team_ids" binding:"required,min=2"`
+}
+
+type EnrollTeamRequest struct {
+	TeamID uuid.UUID `json:"team_id" binding:"required"`
 }
 
 type UpdateChampionshipRequest struct {
-	Name             string                  `json:"name" binding:"required"`
-	Type             entity.ChampionshipType `json:"type" binding:"required,oneof=league cup"`
-	TiebreakerMethod entity.TiebreakerMethod `json:"tiebreaker_method" validate:"required,oneof=penalties extra_time"`
-	ProgressionType  entity.ProgressionType  `json:"progression_type" validate:"required,oneof=fixed random_draw"`
+	Name                 string                      `json:"name" binding:"required"`
+	Type                 entity.ChampionshipType     `json:"type" binding:"required,oneof=league cup groups_knockout swiss"`
+	TiebreakerMethod     entity.TiebreakerMethod     `json:"tiebreaker_method" validate:"required,oneof=penalties extra_time"`
+	ProgressionType      entity.ProgressionType      `json:"progression_type" validate:"required,oneof=fixed random_draw"`
+	NumGroups            int                         `json:"num_groups" binding:"gte=0"`
+	QualifiersPerGroup   int                         `json:"qualifiers_per_group" binding:"gte=0"`
+	Legs                 int                         `json:"legs" binding:"omitempty,oneof=1 2 4"`
+	SwissRounds          int                         `json:"swiss_rounds" binding:"gte=0"`
+	DoubleElimination    bool                        `json:"double_elimination"`
+	BracketReset         bool                        `json:"bracket_reset"`
+	ThirdPlaceMatch      bool                        `json:"third_place_match"`
+	TwoLeggedTies        bool                        `json:"two_legged_ties"`
+	TwoLeggedPhases      []int                       `json:"two_legged_phases" binding:"dive,gte=1"`
+	AwayGoals            bool                        `json:"away_goals"`
+	PointsSystem         *entity.PointsSystem        `json:"points_system"`
+	Tiebreakers          []entity.StandingsCriterion `json:"tiebreakers" binding:"dive,oneof=goal_difference goals_for goals_against wins away_goals head_to_head head_to_head_points head_to_head_goal_difference drawing_of_lots"`
+	DisqualificationRule entity.DisqualificationRule `json:"disqualification_rule" binding:"omitempty,oneof=void award"`
+	DisciplinaryRules    *entity.DisciplinaryRules   `json:"disciplinary_rules"`
+	SquadRules           *entity.SquadRules          `json:"squad_rules"`
+	Seeds                []uuid.UUID                 `json:"seeds"`
 }
 
 func (h *ChampionshipHandler) CreateChampionship(c *gin.Context) {
@@ -42,13 +79,30 @@ func (h *ChampionshipHandler) CreateChampionship(c *gin.Context) {
 	}
 
 	championship := &entity.Championship{
-		ID:               uuid.New(),
-		Name:             req.Name,
-		Type:             entity.ChampionshipType(req.Type),
-		TiebreakerMethod: entity.TiebreakerMethod(req.TiebreakerMethod),
-		ProgressionType:  entity.ProgressionType(req.ProgressionType),
-		UpdatedAt:        time.Now(),
-		CreatedAt:        time.Now(),
+		ID:                   uuid.New(),
+		Name:                 req.Name,
+		Type:                 entity.ChampionshipType(req.Type),
+		TiebreakerMethod:     entity.TiebreakerMethod(req.TiebreakerMethod),
+		ProgressionType:      entity.ProgressionType(req.ProgressionType),
+		NumGroups:            req.NumGroups,
+		QualifiersPerGroup:   req.QualifiersPerGroup,
+		Legs:                 req.Legs,
+		SwissRounds:          req.SwissRounds,
+		DoubleElimination:    req.DoubleElimination,
+		BracketReset:         req.BracketReset,
+		ThirdPlaceMatch:      req.ThirdPlaceMatch,
+		TwoLeggedTies:        req.TwoLeggedTies,
+		TwoLeggedPhases:      req.TwoLeggedPhases,
+		AwayGoals:            req.AwayGoals,
+		PointsSystem:         req.PointsSystem,
+		Tiebreakers:          req.Tiebreakers,
+		DisqualificationRule: req.DisqualificationRule,
+		DisciplinaryRules:    req.DisciplinaryRules,
+		SquadRules:           req.SquadRules,
+		Seeds:                req.Seeds,
+		DrawSeed:             req.DrawSeed,
+		UpdatedAt:            time.Now(),
+		CreatedAt:            time.Now(),
 	}
 
 	if err := h.service.CreateChampionship(c.Request.Context(), championship, req.TeamIDs); err != nil {
@@ -95,11 +149,27 @@ func (h *ChampionshipHandler) UpdateChampionship(c *gin.Context) {
 	}
 
 	championship := &entity.Championship{
-		ID:               championshipID,
-		Name:             req.Name,
-		Type:             entity.ChampionshipType(req.Type),
-		TiebreakerMethod: entity.TiebreakerMethod(req.TiebreakerMethod),
-		ProgressionType:  entity.ProgressionType(req.ProgressionType),
+		ID:                   championshipID,
+		Name:                 req.Name,
+		Type:                 entity.ChampionshipType(req.Type),
+		TiebreakerMethod:     entity.TiebreakerMethod(req.TiebreakerMethod),
+		ProgressionType:      entity.ProgressionType(req.ProgressionType),
+		NumGroups:            req.NumGroups,
+		QualifiersPerGroup:   req.QualifiersPerGroup,
+		Legs:                 req.Legs,
+		SwissRounds:          req.SwissRounds,
+		DoubleElimination:    req.DoubleElimination,
+		BracketReset:         req.BracketReset,
+		ThirdPlaceMatch:      req.ThirdPlaceMatch,
+		TwoLeggedTies:        req.TwoLeggedTies,
+		TwoLeggedPhases:      req.TwoLeggedPhases,
+		AwayGoals:            req.AwayGoals,
+		PointsSystem:         req.PointsSystem,
+		Tiebreakers:          req.Tiebreakers,
+		DisqualificationRule: req.DisqualificationRule,
+		DisciplinaryRules:    req.DisciplinaryRules,
+		SquadRules:           req.SquadRules,
+		Seeds:                req.Seeds,
 	}
 
 	if err := h.service.UpdateChampionship(c.Request.Context(), championship); err != nil {
@@ -135,3 +205,113 @@ func (h *ChampionshipHandler) ListChampionships(c *gin.Context) {
 
 	web.RespondWithJSON(c, http.StatusOK, championships)
 }
+
+func (h *ChampionshipHandler) EnrollTeam(c *gin.Context) {
+	idParam := c.Param("id")
+	championshipID, err := uuid.Parse(idParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "invalid championship ID")
+		return
+	}
+
+	var req EnrollTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.service.EnrollTeam(c.Request.Context(), championshipID, req.TeamID); err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusCreated, gin.H{"message": "team enrolled successfully"})
+}
+
+func (h *ChampionshipHandler) WithdrawTeam(c *gin.Context) {
+	idParam := c.Param("id")
+	championshipID, err := uuid.Parse(idParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "invalid championship ID")
+		return
+	}
+
+	teamIDParam := c.Param("team_id")
+	teamID, err := uuid.Parse(teamIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "invalid team ID")
+		return
+	}
+
+	if err := h.service.WithdrawTeam(c.Request.Context(), championshipID, teamID); err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "team withdrawn successfully"})
+}
+
+func (h *ChampionshipHandler) ListTeams(c *gin.Context) {
+	idParam := c.Param("id")
+	championshipID, err := uuid.Parse(idParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "invalid championship ID")
+		return
+	}
+
+	teams, err := h.service.ListTeams(c.Request.Context(), championshipID)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, teams)
+}
+
+func (h *ChampionshipHandler) OpenRegistration(c *gin.Context) {
+	idParam := c.Param("id")
+	championshipID, err := uuid.Parse(idParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "invalid championship ID")
+		return
+	}
+
+	if err := h.service.OpenRegistration(c.Request.Context(), championshipID); err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "championship registration opened successfully"})
+}
+
+func (h *ChampionshipHandler) StartChampionship(c *gin.Context) {
+	idParam := c.Param("id")
+	championshipID, err := uuid.Parse(idParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "invalid championship ID")
+		return
+	}
+
+	if err := h.service.StartChampionship(c.Request.Context(), championshipID); err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "championship started successfully"})
+}
+
+func (h *ChampionshipHandler) CancelChampionship(c *gin.Context) {
+	idParam := c.Param("id")
+	championshipID, err := uuid.Parse(idParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "invalid championship ID")
+		return
+	}
+
+	if err := h.service.CancelChampionship(c.Request.Context(), championshipID); err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "championship cancelled successfully"})
+}
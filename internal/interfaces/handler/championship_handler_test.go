@@ -67,10 +67,11 @@ func TestChampionshipHandler_CreateChampionship_Success(t *testing.T) {
 	defer teardownTestDB(t, pool)
 
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	messagePublisher := &MockMessagePublisher{}
 
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
 
 	ctx := context.Background()
 
@@ -151,10 +152,11 @@ func TestChampionshipHandler_CreateChampionship_InvalidData(t *testing.T) {
 	defer teardownTestDB(t, pool)
 
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	messagePublisher := &MockMessagePublisher{}
 
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
 	championshipHandler := handler.NewChampionshipHandler(championshipService)
 
 	gin.SetMode(gin.TestMode)
@@ -190,10 +192,11 @@ func TestChampionshipHandler_CreateChampionship_TeamNotFound(t *testing.T) {
 	defer teardownTestDB(t, pool)
 
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	messagePublisher := &MockMessagePublisher{}
 
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
 	championshipHandler := handler.NewChampionshipHandler(championshipService)
 
 	gin.SetMode(gin.TestMode)
@@ -233,10 +236,11 @@ func TestChampionshipHandler_GetChampionshipByID_Success(t *testing.T) {
 
 	ctx := context.Background()
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	messagePublisher := &MockMessagePublisher{}
 
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
 	championshipHandler := handler.NewChampionshipHandler(championshipService)
 
 	// Criar um campeonato de teste
@@ -296,10 +300,11 @@ func TestChampionshipHandler_GetChampionshipByID_NotFound(t *testing.T) {
 	defer teardownTestDB(t, pool)
 
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	messagePublisher := &MockMessagePublisher{}
 
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
 	championshipHandler := handler.NewChampionshipHandler(championshipService)
 
 	gin.SetMode(gin.TestMode)
@@ -325,10 +330,11 @@ func TestChampionshipHandler_UpdateChampionship_Success(t *testing.T) {
 
 	ctx := context.Background()
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	messagePublisher := &MockMessagePublisher{}
 
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
 	championshipHandler := handler.NewChampionshipHandler(championshipService)
 
 	// Criar um campeonato de teste
@@ -378,16 +384,132 @@ func TestChampionshipHandler_UpdateChampionship_Success(t *testing.T) {
 	assert.Equal(t, updateData.ProgressionType, updatedChampionship.ProgressionType)
 }
 
+func TestChampionshipHandler_UpdateChampionship_KeepsSeeds(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
+	teamRepo := repository.NewTeamRepositoryPg(pool)
+	userRepo := repository.NewUserRepositoryPg(pool)
+	messagePublisher := &MockMessagePublisher{}
+
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
+	championshipHandler := handler.NewChampionshipHandler(championshipService)
+
+	user := &entity.User{
+		ID:           uuid.New(),
+		Name:         "User",
+		Email:        "teste@gmail.com",
+		PasswordHash: "hashedpassword",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	require.NoError(t, userRepo.Create(ctx, user))
+
+	team1 := &entity.Team{ID: uuid.New(), Name: "Team 1", UserID: user.ID, CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	team2 := &entity.Team{ID: uuid.New(), Name: "Team 2", UserID: user.ID, CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	require.NoError(t, teamRepo.Create(ctx, team1))
+	require.NoError(t, teamRepo.Create(ctx, team2))
+
+	championship := &entity.Championship{
+		Name:             "Seeded Cup",
+		Type:             entity.ChampionshipTypeCup,
+		TiebreakerMethod: entity.TiebreakerPenalties,
+		ProgressionType:  entity.ProgressionFixed,
+		Seeds:            []uuid.UUID{team2.ID},
+	}
+	require.NoError(t, championshipService.CreateChampionship(ctx, championship, []uuid.UUID{team1.ID, team2.ID}))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.PUT("/championships/:id", championshipHandler.UpdateChampionship)
+
+	// Atualização sem cabeças de chave mantém os já cadastrados
+	updateData := handler.UpdateChampionshipRequest{
+		Name:             "Renamed Cup",
+		Type:             entity.ChampionshipTypeCup,
+		TiebreakerMethod: entity.TiebreakerPenalties,
+		ProgressionType:  entity.ProgressionFixed,
+	}
+	jsonBody, err := json.Marshal(updateData)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPut, "/championships/"+championship.ID.String(), bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	updatedChampionship, err := championshipRepo.GetByID(ctx, championship.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed Cup", updatedChampionship.Name)
+	assert.Equal(t, []uuid.UUID{team2.ID}, updatedChampionship.Seeds)
+	assert.Zero(t, updatedChampionship.DrawSeed)
+
+	// Ao passar para random_draw, a semente dos sorteios é registrada e depois mantida
+	updateData.ProgressionType = entity.ProgressionRandomDraw
+	jsonBody, err = json.Marshal(updateData)
+	require.NoError(t, err)
+
+	req, err = http.NewRequest(http.MethodPut, "/championships/"+championship.ID.String(), bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	updatedChampionship, err = championshipRepo.GetByID(ctx, championship.ID)
+	require.NoError(t, err)
+	drawSeed := updatedChampionship.DrawSeed
+	assert.NotZero(t, drawSeed)
+
+	updateData.Name = "Renamed Again"
+	jsonBody, err = json.Marshal(updateData)
+	require.NoError(t, err)
+
+	req, err = http.NewRequest(http.MethodPut, "/championships/"+championship.ID.String(), bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	updatedChampionship, err = championshipRepo.GetByID(ctx, championship.ID)
+	require.NoError(t, err)
+	assert.Equal(t, drawSeed, updatedChampionship.DrawSeed)
+
+	// Cabeças de chave que não estão inscritos são rejeitados
+	updateData.Seeds = []uuid.UUID{uuid.New()}
+	jsonBody, err = json.Marshal(updateData)
+	require.NoError(t, err)
+
+	req, err = http.NewRequest(http.MethodPut, "/championships/"+championship.ID.String(), bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+}
+
 func TestChampionshipHandler_UpdateChampionship_NotFound(t *testing.T) {
 	pool := setupTestDB(t)
 	defer pool.Close()
 	defer teardownTestDB(t, pool)
 
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	messagePublisher := &MockMessagePublisher{}
 
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
 	championshipHandler := handler.NewChampionshipHandler(championshipService)
 
 	gin.SetMode(gin.TestMode)
@@ -425,10 +547,11 @@ func TestChampionshipHandler_DeleteChampionship_Success(t *testing.T) {
 
 	ctx := context.Background()
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	messagePublisher := &MockMessagePublisher{}
 
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
 	championshipHandler := handler.NewChampionshipHandler(championshipService)
 
 	// Criar um campeonato de teste
@@ -465,10 +588,11 @@ func TestChampionshipHandler_DeleteChampionship_NotFound(t *testing.T) {
 	defer teardownTestDB(t, pool)
 
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	messagePublisher := &MockMessagePublisher{}
 
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
 	championshipHandler := handler.NewChampionshipHandler(championshipService)
 
 	gin.SetMode(gin.TestMode)
@@ -494,10 +618,11 @@ func TestChampionshipHandler_ListChampionships_Success(t *testing.T) {
 
 	ctx := context.Background()
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	messagePublisher := &MockMessagePublisher{}
 
-	championshipService := service.NewChampionshipService(championshipRepo, teamRepo, messagePublisher)
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
 	championshipHandler := handler.NewChampionshipHandler(championshipService)
 
 	// Criar campeonatos de teste
@@ -546,3 +671,87 @@ func TestChampionshipHandler_ListChampionships_Success(t *testing.T) {
 	assert.Contains(t, names, "Championship 1")
 	assert.Contains(t, names, "Championship 2")
 }
+
+func TestChampionshipHandler_EnrollAndListTeams(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	championshipTeamRepo := repository.NewChampionshipTeamRepositoryPg(pool)
+	teamRepo := repository.NewTeamRepositoryPg(pool)
+	userRepo := repository.NewUserRepositoryPg(pool)
+	messagePublisher := &MockMessagePublisher{}
+
+	championshipService := service.NewChampionshipService(championshipRepo, championshipTeamRepo, teamRepo, messagePublisher)
+
+	ctx := context.Background()
+
+	userID := uuid.New()
+	user := &entity.User{
+		ID:           userID,
+		Name:         "User",
+		Email:        "teste@gmail.com",
+		PasswordHash: "hashedpassword",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	err := userRepo.Create(ctx, user)
+	require.NoError(t, err)
+
+	var teamIDs []uuid.UUID
+	for _, name := range []string{"Team 1", "Team 2", "Team 3"} {
+		team := &entity.Team{
+			ID:        uuid.New(),
+			Name:      name,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+			UserID:    userID,
+		}
+		err = teamRepo.Create(ctx, team)
+		require.NoError(t, err)
+		teamIDs = append(teamIDs, team.ID)
+	}
+
+	championship := &entity.Championship{
+		Name:             "Test Championship",
+		Type:             entity.ChampionshipTypeLeague,
+		TiebreakerMethod: entity.TiebreakerPenalties,
+		ProgressionType:  entity.ProgressionFixed,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+		ID:               uuid.New(),
+	}
+	err = championshipService.CreateChampionship(ctx, championship, teamIDs[:2])
+	require.NoError(t, err)
+
+	championshipHandler := handler.NewChampionshipHandler(championshipService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.POST("/championships/:id/teams", championshipHandler.EnrollTeam)
+	router.GET("/championships/:id/teams", championshipHandler.ListTeams)
+
+	jsonBody, err := json.Marshal(handler.EnrollTeamRequest{TeamID: teamIDs[2]})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "/championships/"+championship.ID.String()+"/teams", bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	req, err = http.NewRequest(http.MethodGet, "/championships/"+championship.ID.String()+"/teams", nil)
+	require.NoError(t, err)
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var teams []entity.Team
+	err = json.Unmarshal(recorder.Body.Bytes(), &teams)
+	require.NoError(t, err)
+	assert.Len(t, teams, 3)
+}
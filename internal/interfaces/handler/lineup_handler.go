@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"bytes"
+	"champi-maker/internal/application/service"
+	"champi-maker/internal/domain/entity"
+	"champi-maker/pkg/web"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type LineupHandler struct {
+	lineupService service.LineupService
+}
+
+func NewLineupHandler(lineupService service.LineupService) *LineupHandler {
+	return &LineupHandler{
+		lineupService: lineupService,
+	}
+}
+
+type SubmitLineupRequest struct {
+	Starters    []uuid.UUID `json:"starters" binding:"required,min=1"`
+	Substitutes []uuid.UUID `json:"substitutes"`
+}
+
+// parseMatchAndTeam lê os IDs da partida e do time da rota.
+func parseMatchAndTeam(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID da partida inválido")
+		return uuid.Nil, uuid.Nil, false
+	}
+	teamID, err := uuid.Parse(c.Param("team_id"))
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID do time inválido")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return matchID, teamID, true
+}
+
+// SubmitLineup grava os titulares e os reservas do time para a partida.
+func (h *LineupHandler) SubmitLineup(c *gin.Context) {
+	matchID, teamID, ok := parseMatchAndTeam(c)
+	if !ok {
+		return
+	}
+
+	var req SubmitLineupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	lineup := &entity.Lineup{MatchID: matchID, TeamID: teamID}
+	for _, playerID := range req.Starters {
+		lineup.Players = append(lineup.Players, &entity.LineupPlayer{PlayerID: playerID, Starter: true})
+	}
+	for _, playerID := range req.Substitutes {
+		lineup.Players = append(lineup.Players, &entity.LineupPlayer{PlayerID: playerID})
+	}
+
+	if err := h.lineupService.SubmitLineup(c.Request.Context(), lineup); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, lineup)
+}
+
+func (h *LineupHandler) GetLineup(c *gin.Context) {
+	matchID, teamID, ok := parseMatchAndTeam(c)
+	if !ok {
+		return
+	}
+
+	lineup, err := h.lineupService.GetLineup(c.Request.Context(), matchID, teamID)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if lineup == nil {
+		web.RespondWithError(c, http.StatusNotFound, "Escalação não encontrada")
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, lineup)
+}
+
+func (h *LineupHandler) ListLineups(c *gin.Context) {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID da partida inválido")
+		return
+	}
+
+	lineups, err := h.lineupService.ListLineups(c.Request.Context(), matchID)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, lineups)
+}
+
+func (h *LineupHandler) DeleteLineup(c *gin.Context) {
+	matchID, teamID, ok := parseMatchAndTeam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.lineupService.DeleteLineup(c.Request.Context(), matchID, teamID); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "Escalação removida com sucesso"})
+}
+
+// GetMatchSheet devolve a súmula da partida em HTML, pronta para impressão. Com format=json,
+// devolve os dados da súmula.
+func (h *LineupHandler) GetMatchSheet(c *gin.Context) {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID da partida inválido")
+		return
+	}
+
+	if c.Query("format") == "json" {
+		sheet, err := h.lineupService.GetMatchSheet(c.Request.Context(), matchID)
+		if err != nil {
+			web.RespondWithError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		web.RespondWithJSON(c, http.StatusOK, sheet)
+		return
+	}
+
+	var document bytes.Buffer
+	if err := h.lineupService.ExportMatchSheet(c.Request.Context(), matchID, &document); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Data(http.StatusOK, "text/html; charset=utf-8", document.Bytes())
+}
@@ -0,0 +1,86 @@
+package handler_test
+
+import (
+	"bytes"
+	"champi-maker/internal/application/service"
+	"champi-maker/internal/infrastructure/document"
+	"champi-maker/internal/infrastructure/repository"
+	"champi-maker/internal/interfaces/handler"
+
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLineupHandler(t *testing.T, pool *pgxpool.Pool) *handler.LineupHandler {
+	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
+
+	renderer, err := document.NewHTMLMatchSheetRenderer()
+	require.NoError(t, err)
+
+	lineupService := service.NewLineupService(
+		repository.NewLineupRepositoryPg(pool),
+		matchRepo,
+		championshipRepo,
+		repository.NewTeamRepositoryPg(pool),
+		repository.NewChampionshipPlayerRepositoryPg(pool),
+		service.NewSuspensionService(repository.NewSuspensionRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo),
+		renderer,
+	)
+	return handler.NewLineupHandler(lineupService)
+}
+
+func TestLineupHandler_SubmitLineup_WithoutStarters(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	lineupHandler := newLineupHandler(t, pool)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.PUT("/matches/:id/lineups/:team_id", lineupHandler.SubmitLineup)
+
+	jsonBody, err := json.Marshal(map[string]interface{}{
+		"substitutes": []uuid.UUID{uuid.New()},
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPut, "/matches/"+uuid.New().String()+"/lineups/"+uuid.New().String(), bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestLineupHandler_GetMatchSheet_MatchNotFound(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	lineupHandler := newLineupHandler(t, pool)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.GET("/matches/:id/sheet", lineupHandler.GetMatchSheet)
+
+	req, err := http.NewRequest(http.MethodGet, "/matches/"+uuid.New().String()+"/sheet", nil)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
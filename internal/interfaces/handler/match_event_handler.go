@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"champi-maker/internal/application/service"
+	"champi-maker/internal/domain/entity"
+	"champi-maker/pkg/web"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type MatchEventHandler struct {
+	matchEventService service.MatchEventService
+}
+
+func NewMatchEventHandler(matchEventService service.MatchEventService) *MatchEventHandler {
+	return &MatchEventHandler{
+		matchEventService: matchEventService,
+	}
+}
+
+type CreateMatchEventRequest struct {
+	TeamID               uuid.UUID             `json:"team_id" binding:"required"`
+	Type                 entity.MatchEventType `json:"type" binding:"required,oneof=goal own_goal yellow_card red_card substitution"`
+	Minute               int                   `json:"minute" binding:"gte=0,lte=150"`
+	ExtraTime            bool                  `json:"extra_time"`
+	PlayerID             *uuid.UUID            `json:"player_id"`
+	PlayerName           string                `json:"player_name" binding:"required_without=PlayerID,max=100"`
+	AssistPlayerID       *uuid.UUID            `json:"assist_player_id"`
+	SubstitutePlayerID   *uuid.UUID            `json:"substitute_player_id"`
+	SubstitutePlayerName string                `json:"substitute_player_name" binding:"max=100"`
+}
+
+func (h *MatchEventHandler) CreateMatchEvent(c *gin.Context) {
+	matchIDParam := c.Param("id")
+	matchID, err := uuid.Parse(matchIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID da partida inválido")
+		return
+	}
+
+	var req CreateMatchEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	event := &entity.MatchEvent{
+		ID:                   uuid.New(),
+		MatchID:              matchID,
+		TeamID:               req.TeamID,
+		Type:                 req.Type,
+		Minute:               req.Minute,
+		ExtraTime:            req.ExtraTime,
+		PlayerID:             req.PlayerID,
+		PlayerName:           req.PlayerName,
+		AssistPlayerID:       req.AssistPlayerID,
+		SubstitutePlayerID:   req.SubstitutePlayerID,
+		SubstitutePlayerName: req.SubstitutePlayerName,
+		CreatedAt:            time.Now(),
+	}
+
+	if err := h.matchEventService.AddEvent(c.Request.Context(), event); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusCreated, event)
+}
+
+func (h *MatchEventHandler) ListMatchEvents(c *gin.Context) {
+	matchIDParam := c.Param("id")
+	matchID, err := uuid.Parse(matchIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID da partida inválido")
+		return
+	}
+
+	events, err := h.matchEventService.ListEventsByMatch(c.Request.Context(), matchID)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, events)
+}
+
+func (h *MatchEventHandler) DeleteMatchEvent(c *gin.Context) {
+	matchIDParam := c.Param("id")
+	matchID, err := uuid.Parse(matchIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID da partida inválido")
+		return
+	}
+
+	eventID, err := uuid.Parse(c.Param("event_id"))
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID do evento inválido")
+		return
+	}
+
+	if err := h.matchEventService.DeleteEvent(c.Request.Context(), matchID, eventID); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "Evento removido com sucesso"})
+}
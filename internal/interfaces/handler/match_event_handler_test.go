@@ -0,0 +1,180 @@
+package handler_test
+
+import (
+	"bytes"
+	"champi-maker/internal/application/service"
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/infrastructure/repository"
+	"champi-maker/internal/interfaces/handler"
+
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchEventHandler_CreateMatchEvent_UpdatesScore(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+
+	userRepo := repository.NewUserRepositoryPg(pool)
+	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
+	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
+	teamRepo := repository.NewTeamRepositoryPg(pool)
+
+	championshipPlayerRepo := repository.NewChampionshipPlayerRepositoryPg(pool)
+	playerStatisticsService := service.NewPlayerStatisticsService(repository.NewPlayerStatisticsRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo, repository.NewLineupRepositoryPg(pool), repository.NewPlayerRepositoryPg(pool))
+	suspensionService := service.NewSuspensionService(repository.NewSuspensionRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo)
+
+	matchEventService := service.NewMatchEventService(matchEventRepo, matchRepo, championshipPlayerRepo, repository.NewLineupRepositoryPg(pool), playerStatisticsService, suspensionService)
+	matchEventHandler := handler.NewMatchEventHandler(matchEventService)
+
+	championship := &entity.Championship{
+		ID:               uuid.New(),
+		Name:             "Test Championship",
+		Type:             entity.ChampionshipTypeLeague,
+		TiebreakerMethod: entity.TiebreakerExtraTime,
+		ProgressionType:  entity.ProgressionFixed,
+		Status:           entity.ChampionshipStatusInProgress,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+	err := championshipRepo.Create(ctx, championship)
+	require.NoError(t, err)
+
+	user := &entity.User{
+		ID:           uuid.New(),
+		Name:         "User",
+		Email:        "email@email.co",
+		PasswordHash: "password",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	err = userRepo.Create(ctx, user)
+	require.NoError(t, err)
+
+	team1 := &entity.Team{
+		ID:        uuid.New(),
+		Name:      "Team 1",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		UserID:    user.ID,
+	}
+	team2 := &entity.Team{
+		ID:        uuid.New(),
+		Name:      "Team 2",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		UserID:    user.ID,
+	}
+	err = teamRepo.Create(ctx, team1)
+	require.NoError(t, err)
+	err = teamRepo.Create(ctx, team2)
+	require.NoError(t, err)
+
+	match := &entity.Match{
+		ID:             uuid.New(),
+		ChampionshipID: championship.ID,
+		HomeTeamID:     &team1.ID,
+		AwayTeamID:     &team2.ID,
+		Status:         entity.MatchStatusInProgress,
+		Phase:          1,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	err = matchRepo.Create(ctx, match)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.POST("/matches/:id/events", matchEventHandler.CreateMatchEvent)
+	router.GET("/matches/:id/events", matchEventHandler.ListMatchEvents)
+
+	eventData := map[string]interface{}{
+		"team_id":     team2.ID,
+		"type":        entity.MatchEventGoal,
+		"minute":      33,
+		"player_name": "Jogador",
+	}
+	jsonBody, err := json.Marshal(eventData)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "/matches/"+match.ID.String()+"/events", bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	// O gol atualiza o placar da partida em andamento
+	updatedMatch, err := matchRepo.GetByID(ctx, match.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, updatedMatch.ScoreHome)
+	assert.Equal(t, 1, updatedMatch.ScoreAway)
+
+	req, err = http.NewRequest(http.MethodGet, "/matches/"+match.ID.String()+"/events", nil)
+	require.NoError(t, err)
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var events []entity.MatchEvent
+	err = json.Unmarshal(recorder.Body.Bytes(), &events)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, team2.ID, events[0].TeamID)
+}
+
+func TestMatchEventHandler_CreateMatchEvent_InvalidData(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
+
+	championshipPlayerRepo := repository.NewChampionshipPlayerRepositoryPg(pool)
+	playerStatisticsService := service.NewPlayerStatisticsService(repository.NewPlayerStatisticsRepositoryPg(pool), repository.NewChampionshipRepositoryPg(pool), matchRepo, matchEventRepo, repository.NewLineupRepositoryPg(pool), repository.NewPlayerRepositoryPg(pool))
+	suspensionService := service.NewSuspensionService(repository.NewSuspensionRepositoryPg(pool), repository.NewChampionshipRepositoryPg(pool), matchRepo, matchEventRepo)
+
+	matchEventService := service.NewMatchEventService(matchEventRepo, matchRepo, championshipPlayerRepo, repository.NewLineupRepositoryPg(pool), playerStatisticsService, suspensionService)
+	matchEventHandler := handler.NewMatchEventHandler(matchEventService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.POST("/matches/:id/events", matchEventHandler.CreateMatchEvent)
+
+	// Substituição sem o jogador que entra
+	eventData := map[string]interface{}{
+		"team_id":     uuid.New(),
+		"type":        entity.MatchEventSubstitution,
+		"minute":      60,
+		"player_name": "Jogador",
+	}
+	jsonBody, err := json.Marshal(eventData)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "/matches/"+uuid.New().String()+"/events", bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -3,7 +3,10 @@ package handler
 import (
 	"champi-maker/internal/application/service"
 	"champi-maker/pkg/web"
+	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -19,15 +22,58 @@ func NewMatchHandler(matchService service.MatchService) *MatchHandler {
 	}
 }
 
+// UpdateMatchResultRequest traz o resultado de uma partida, usado no lançamento e no
+// encerramento. Placares zerados são válidos; por isso os campos não são obrigatórios.
 type UpdateMatchResultRequest struct {
-	ScoreHome          int  `json:"score_home" binding:"required"`
-	ScoreAway          int  `json:"score_away" binding:"required"`
+	ScoreHome          int  `json:"score_home" binding:"gte=0"`
+	ScoreAway          int  `json:"score_away" binding:"gte=0"`
 	HasExtraTime       bool `json:"has_extra_time"`
-	ScoreHomeExtraTime int  `json:"score_home_extra_time,omitempty"`
-	ScoreAwayExtraTime int  `json:"score_away_extra_time,omitempty"`
+	ScoreHomeExtraTime int  `json:"score_home_extra_time,omitempty" binding:"gte=0"`
+	ScoreAwayExtraTime int  `json:"score_away_extra_time,omitempty" binding:"gte=0"`
 	HasPenalties       bool `json:"has_penalties"`
-	ScoreHomePenalties int  `json:"score_home_penalties,omitempty"`
-	ScoreAwayPenalties int  `json:"score_away_penalties,omitempty"`
+	ScoreHomePenalties int  `json:"score_home_penalties,omitempty" binding:"gte=0"`
+	ScoreAwayPenalties int  `json:"score_away_penalties,omitempty" binding:"gte=0"`
+}
+
+// CorrectMatchResultRequest traz o novo resultado de uma partida encerrada. Cascade confirma
+// a anulação das partidas seguintes já disputadas que forem afetadas pela correção.
+type CorrectMatchResultRequest struct {
+	ScoreHome          int  `json:"score_home" binding:"gte=0"`
+	ScoreAway          int  `json:"score_away" binding:"gte=0"`
+	HasExtraTime       bool `json:"has_extra_time"`
+	ScoreHomeExtraTime int  `json:"score_home_extra_time,omitempty" binding:"gte=0"`
+	ScoreAwayExtraTime int  `json:"score_away_extra_time,omitempty" binding:"gte=0"`
+	HasPenalties       bool `json:"has_penalties"`
+	ScoreHomePenalties int  `json:"score_home_penalties,omitempty" binding:"gte=0"`
+	ScoreAwayPenalties int  `json:"score_away_penalties,omitempty" binding:"gte=0"`
+	Cascade            bool `json:"cascade"`
+}
+
+// UpdateLiveScoreRequest traz o placar parcial de uma partida em andamento.
+type UpdateLiveScoreRequest struct {
+	ScoreHome int `json:"score_home" binding:"gte=0"`
+	ScoreAway int `json:"score_away" binding:"gte=0"`
+}
+
+// PostponeMatchRequest traz a nova data da partida adiada, quando já conhecida.
+type PostponeMatchRequest struct {
+	MatchDate *time.Time `json:"match_date"`
+}
+
+// AbandonMatchRequest indica, opcionalmente, o time que recebe a vitória da partida
+// abandonada. Sem placar informado, a vitória é de 3 a 0.
+type AbandonMatchRequest struct {
+	AwardedTeamID *uuid.UUID `json:"awarded_team_id"`
+	ScoreWinner   int        `json:"score_winner" binding:"gte=0"`
+	ScoreLoser    int        `json:"score_loser" binding:"gte=0"`
+}
+
+// AwardForfeitRequest indica o time que recebe a vitória por W.O. Sem placar informado, a
+// vitória é de 3 a 0.
+type AwardForfeitRequest struct {
+	AwardedTeamID uuid.UUID `json:"awarded_team_id" binding:"required"`
+	ScoreWinner   int       `json:"score_winner" binding:"gte=0"`
+	ScoreLoser    int       `json:"score_loser" binding:"gte=0"`
 }
 
 func (h *MatchHandler) GetMatchByID(c *gin.Context) {
@@ -48,7 +94,7 @@ func (h *MatchHandler) GetMatchByID(c *gin.Context) {
 }
 
 func (h *MatchHandler) ListMatchesByChampionship(c *gin.Context) {
-	championshipIDParam := c.Param("championship_id")
+	championshipIDParam := c.Param("id")
 	championshipID, err := uuid.Parse(championshipIDParam)
 	if err != nil {
 		web.RespondWithError(c, http.StatusBadRequest, "ID do campeonato inválido")
@@ -64,6 +110,45 @@ func (h *MatchHandler) ListMatchesByChampionship(c *gin.Context) {
 	web.RespondWithJSON(c, http.StatusOK, matches)
 }
 
+func (h *MatchHandler) ListMatchesByRound(c *gin.Context) {
+	championshipIDParam := c.Param("id")
+	championshipID, err := uuid.Parse(championshipIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID do campeonato inválido")
+		return
+	}
+
+	round, err := strconv.Atoi(c.Param("round"))
+	if err != nil || round < 1 {
+		web.RespondWithError(c, http.StatusBadRequest, "Número da rodada inválido")
+		return
+	}
+
+	matches, err := h.matchService.ListMatchesByRound(c.Request.Context(), championshipID, round)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, matches)
+}
+
+func (h *MatchHandler) GenerateNextRound(c *gin.Context) {
+	championshipIDParam := c.Param("id")
+	championshipID, err := uuid.Parse(championshipIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID do campeonato inválido")
+		return
+	}
+
+	if err := h.matchService.GenerateNextSwissRound(c.Request.Context(), championshipID); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusCreated, gin.H{"message": "Próxima rodada gerada com sucesso"})
+}
+
 func (h *MatchHandler) UpdateMatchResult(c *gin.Context) {
 	matchIDParam := c.Param("id")
 	matchID, err := uuid.Parse(matchIDParam)
@@ -97,3 +182,225 @@ func (h *MatchHandler) UpdateMatchResult(c *gin.Context) {
 
 	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "Resultado da partida atualizado com sucesso"})
 }
+
+func (h *MatchHandler) CorrectMatchResult(c *gin.Context) {
+	matchIDParam := c.Param("id")
+	matchID, err := uuid.Parse(matchIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID da partida inválido")
+		return
+	}
+
+	var req CorrectMatchResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resultUpdate := service.MatchResultUpdate{
+		ScoreHome:          req.ScoreHome,
+		ScoreAway:          req.ScoreAway,
+		HasExtraTime:       req.HasExtraTime,
+		ScoreHomeExtraTime: req.ScoreHomeExtraTime,
+		ScoreAwayExtraTime: req.ScoreAwayExtraTime,
+		HasPenalties:       req.HasPenalties,
+		ScoreHomePenalties: req.ScoreHomePenalties,
+		ScoreAwayPenalties: req.ScoreAwayPenalties,
+	}
+
+	if err := h.matchService.CorrectMatchResult(c.Request.Context(), matchID, resultUpdate, req.Cascade); err != nil {
+		web.RespondWithError(c, errorStatus(err), err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "Resultado da partida corrigido com sucesso"})
+}
+
+func (h *MatchHandler) StartMatch(c *gin.Context) {
+	matchIDParam := c.Param("id")
+	matchID, err := uuid.Parse(matchIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID da partida inválido")
+		return
+	}
+
+	if err := h.matchService.StartMatch(c.Request.Context(), matchID); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "Partida iniciada com sucesso"})
+}
+
+func (h *MatchHandler) UpdateLiveScore(c *gin.Context) {
+	matchIDParam := c.Param("id")
+	matchID, err := uuid.Parse(matchIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID da partida inválido")
+		return
+	}
+
+	var req UpdateLiveScoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.matchService.UpdateLiveScore(c.Request.Context(), matchID, req.ScoreHome, req.ScoreAway); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "Placar da partida atualizado com sucesso"})
+}
+
+func (h *MatchHandler) FinishMatch(c *gin.Context) {
+	matchIDParam := c.Param("id")
+	matchID, err := uuid.Parse(matchIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID da partida inválido")
+		return
+	}
+
+	var req UpdateMatchResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resultUpdate := service.MatchResultUpdate{
+		ScoreHome:          req.ScoreHome,
+		ScoreAway:          req.ScoreAway,
+		HasExtraTime:       req.HasExtraTime,
+		ScoreHomeExtraTime: req.ScoreHomeExtraTime,
+		ScoreAwayExtraTime: req.ScoreAwayExtraTime,
+		HasPenalties:       req.HasPenalties,
+		ScoreHomePenalties: req.ScoreHomePenalties,
+		ScoreAwayPenalties: req.ScoreAwayPenalties,
+	}
+
+	if err := h.matchService.FinishMatch(c.Request.Context(), matchID, resultUpdate); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "Partida encerrada com sucesso"})
+}
+
+func (h *MatchHandler) PostponeMatch(c *gin.Context) {
+	matchIDParam := c.Param("id")
+	matchID, err := uuid.Parse(matchIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID da partida inválido")
+		return
+	}
+
+	var req PostponeMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.matchService.PostponeMatch(c.Request.Context(), matchID, req.MatchDate); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "Partida adiada com sucesso"})
+}
+
+func (h *MatchHandler) AbandonMatch(c *gin.Context) {
+	matchIDParam := c.Param("id")
+	matchID, err := uuid.Parse(matchIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID da partida inválido")
+		return
+	}
+
+	var req AbandonMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var award *service.MatchAward
+	if req.AwardedTeamID != nil {
+		award = &service.MatchAward{
+			WinnerTeamID: *req.AwardedTeamID,
+			ScoreWinner:  req.ScoreWinner,
+			ScoreLoser:   req.ScoreLoser,
+		}
+	}
+
+	if err := h.matchService.AbandonMatch(c.Request.Context(), matchID, award); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "Partida abandonada com sucesso"})
+}
+
+func (h *MatchHandler) AwardForfeit(c *gin.Context) {
+	matchIDParam := c.Param("id")
+	matchID, err := uuid.Parse(matchIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID da partida inválido")
+		return
+	}
+
+	var req AwardForfeitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	award := service.MatchAward{
+		WinnerTeamID: req.AwardedTeamID,
+		ScoreWinner:  req.ScoreWinner,
+		ScoreLoser:   req.ScoreLoser,
+	}
+
+	if err := h.matchService.AwardForfeit(c.Request.Context(), matchID, award); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "W.O. registrado com sucesso"})
+}
+
+func (h *MatchHandler) DisqualifyTeam(c *gin.Context) {
+	championshipIDParam := c.Param("id")
+	championshipID, err := uuid.Parse(championshipIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID do campeonato inválido")
+		return
+	}
+
+	teamID, err := uuid.Parse(c.Param("team_id"))
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID do time inválido")
+		return
+	}
+
+	if err := h.matchService.DisqualifyTeam(c.Request.Context(), championshipID, teamID); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "Time desclassificado com sucesso"})
+}
+
+// errorStatus escolhe o status da resposta pela categoria do erro devolvido pelo serviço.
+// Erros sem categoria são tratados como falhas internas.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrInvalid):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrConflict):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -29,12 +29,15 @@ func TestMatchHandler_GetMatchByID_Success(t *testing.T) {
 
 	userRepo := repository.NewUserRepositoryPg(pool)
 	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	statisticsRepo := repository.NewStatisticsRepositoryPg(pool)
-	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo)
+	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo, matchRepo, repository.NewCupStatisticsRepositoryPg(pool), repository.NewStandingsSnapshotRepositoryPg(pool))
 
-	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, statisticsService)
+	playerStatisticsService := service.NewPlayerStatisticsService(repository.NewPlayerStatisticsRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo, repository.NewLineupRepositoryPg(pool), repository.NewPlayerRepositoryPg(pool))
+	suspensionService := service.NewSuspensionService(repository.NewSuspensionRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo)
+	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, matchEventRepo, statisticsService, playerStatisticsService, suspensionService)
 	matchHandler := handler.NewMatchHandler(matchService)
 
 	championship := &entity.Championship{
@@ -122,13 +125,16 @@ func TestMatchHandler_ListMatchesByChampionship_Success(t *testing.T) {
 	ctx := context.Background()
 
 	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	userRepo := repository.NewUserRepositoryPg(pool)
 	statisticsRepo := repository.NewStatisticsRepositoryPg(pool)
-	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo)
+	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo, matchRepo, repository.NewCupStatisticsRepositoryPg(pool), repository.NewStandingsSnapshotRepositoryPg(pool))
 
-	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, statisticsService)
+	playerStatisticsService := service.NewPlayerStatisticsService(repository.NewPlayerStatisticsRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo, repository.NewLineupRepositoryPg(pool), repository.NewPlayerRepositoryPg(pool))
+	suspensionService := service.NewSuspensionService(repository.NewSuspensionRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo)
+	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, matchEventRepo, statisticsService, playerStatisticsService, suspensionService)
 	matchHandler := handler.NewMatchHandler(matchService)
 
 	championship := &entity.Championship{
@@ -203,7 +209,7 @@ func TestMatchHandler_ListMatchesByChampionship_Success(t *testing.T) {
 
 	gin.SetMode(gin.TestMode)
 	router := gin.Default()
-	router.GET("/championships/:championship_id/matches", matchHandler.ListMatchesByChampionship)
+	router.GET("/championships/:id/matches", matchHandler.ListMatchesByChampionship)
 
 	req, err := http.NewRequest(http.MethodGet, "/championships/"+championship.ID.String()+"/matches", nil)
 	require.NoError(t, err)
@@ -226,12 +232,15 @@ func TestMatchHandler_UpdateMatchResult_InvalidData(t *testing.T) {
 	defer teardownTestDB(t, pool)
 
 	matchRepo := repository.NewMatchRepositoryPg(pool)
+	matchEventRepo := repository.NewMatchEventRepositoryPg(pool)
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	statisticsRepo := repository.NewStatisticsRepositoryPg(pool)
-	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo)
+	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo, matchRepo, repository.NewCupStatisticsRepositoryPg(pool), repository.NewStandingsSnapshotRepositoryPg(pool))
 
-	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, statisticsService)
+	playerStatisticsService := service.NewPlayerStatisticsService(repository.NewPlayerStatisticsRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo, repository.NewLineupRepositoryPg(pool), repository.NewPlayerRepositoryPg(pool))
+	suspensionService := service.NewSuspensionService(repository.NewSuspensionRepositoryPg(pool), championshipRepo, matchRepo, matchEventRepo)
+	matchService := service.NewMatchService(matchRepo, championshipRepo, teamRepo, matchEventRepo, statisticsService, playerStatisticsService, suspensionService)
 	matchHandler := handler.NewMatchHandler(matchService)
 
 	gin.SetMode(gin.TestMode)
@@ -0,0 +1,241 @@
+package handler
+
+import (
+	"champi-maker/internal/application/service"
+	"champi-maker/internal/domain/entity"
+	"champi-maker/pkg/web"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type PlayerHandler struct {
+	playerService service.PlayerService
+}
+
+func NewPlayerHandler(playerService service.PlayerService) *PlayerHandler {
+	return &PlayerHandler{
+		playerService: playerService,
+	}
+}
+
+type CreatePlayerRequest struct {
+	Name        string                `json:"name" binding:"required,min=2,max=100"`
+	ShirtNumber int                   `json:"shirt_number" binding:"required,gte=1,lte=99"`
+	Position    entity.PlayerPosition `json:"position" binding:"required,oneof=goalkeeper defender midfielder forward"`
+	DateOfBirth *time.Time            `json:"date_of_birth"`
+	JoinedAt    *time.Time            `json:"joined_at"`
+}
+
+type UpdatePlayerRequest struct {
+	Name        string                `json:"name" binding:"required,min=2,max=100"`
+	ShirtNumber int                   `json:"shirt_number" binding:"required,gte=1,lte=99"`
+	Position    entity.PlayerPosition `json:"position" binding:"required,oneof=goalkeeper defender midfielder forward"`
+	DateOfBirth *time.Time            `json:"date_of_birth"`
+	JoinedAt    *time.Time            `json:"joined_at"`
+	LeftAt      *time.Time            `json:"left_at"`
+}
+
+type RegisterPlayerRequest struct {
+	PlayerID uuid.UUID `json:"player_id" binding:"required"`
+}
+
+func (h *PlayerHandler) CreatePlayer(c *gin.Context) {
+	teamIDParam := c.Param("id")
+	teamID, err := uuid.Parse(teamIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de time inválido")
+		return
+	}
+
+	var req CreatePlayerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	player := &entity.Player{
+		ID:          uuid.New(),
+		TeamID:      teamID,
+		Name:        req.Name,
+		ShirtNumber: req.ShirtNumber,
+		Position:    req.Position,
+		DateOfBirth: req.DateOfBirth,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if req.JoinedAt != nil {
+		player.JoinedAt = *req.JoinedAt
+	}
+
+	if err := h.playerService.CreatePlayer(c.Request.Context(), player); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusCreated, player)
+}
+
+func (h *PlayerHandler) GetPlayerByID(c *gin.Context) {
+	idParam := c.Param("id")
+	playerID, err := uuid.Parse(idParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de jogador inválido")
+		return
+	}
+
+	player, err := h.playerService.GetPlayerByID(c.Request.Context(), playerID)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if player == nil {
+		web.RespondWithError(c, http.StatusNotFound, "jogador não encontrado")
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, player)
+}
+
+func (h *PlayerHandler) UpdatePlayer(c *gin.Context) {
+	idParam := c.Param("id")
+	playerID, err := uuid.Parse(idParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de jogador inválido")
+		return
+	}
+
+	var req UpdatePlayerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	player := &entity.Player{
+		ID:          playerID,
+		Name:        req.Name,
+		ShirtNumber: req.ShirtNumber,
+		Position:    req.Position,
+		DateOfBirth: req.DateOfBirth,
+		LeftAt:      req.LeftAt,
+	}
+	if req.JoinedAt != nil {
+		player.JoinedAt = *req.JoinedAt
+	}
+
+	if err := h.playerService.UpdatePlayer(c.Request.Context(), player); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, player)
+}
+
+func (h *PlayerHandler) DeletePlayer(c *gin.Context) {
+	idParam := c.Param("id")
+	playerID, err := uuid.Parse(idParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de jogador inválido")
+		return
+	}
+
+	if err := h.playerService.DeletePlayer(c.Request.Context(), playerID); err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "jogador excluído com sucesso"})
+}
+
+func (h *PlayerHandler) ListPlayersByTeam(c *gin.Context) {
+	teamIDParam := c.Param("id")
+	teamID, err := uuid.Parse(teamIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de time inválido")
+		return
+	}
+
+	players, err := h.playerService.ListPlayersByTeam(c.Request.Context(), teamID)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, players)
+}
+
+// ListSquad devolve os jogadores inscritos pelo time no campeonato.
+func (h *PlayerHandler) ListSquad(c *gin.Context) {
+	championshipID, teamID, ok := parseChampionshipAndTeam(c)
+	if !ok {
+		return
+	}
+
+	players, err := h.playerService.ListSquad(c.Request.Context(), championshipID, teamID)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, players)
+}
+
+func (h *PlayerHandler) RegisterPlayer(c *gin.Context) {
+	championshipID, teamID, ok := parseChampionshipAndTeam(c)
+	if !ok {
+		return
+	}
+
+	var req RegisterPlayerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.playerService.RegisterPlayer(c.Request.Context(), championshipID, teamID, req.PlayerID); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusCreated, gin.H{"message": "Jogador inscrito com sucesso"})
+}
+
+func (h *PlayerHandler) UnregisterPlayer(c *gin.Context) {
+	championshipID, teamID, ok := parseChampionshipAndTeam(c)
+	if !ok {
+		return
+	}
+
+	playerID, err := uuid.Parse(c.Param("player_id"))
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de jogador inválido")
+		return
+	}
+
+	if err := h.playerService.UnregisterPlayer(c.Request.Context(), championshipID, teamID, playerID); err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "Inscrição do jogador removida com sucesso"})
+}
+
+// parseChampionshipAndTeam lê os IDs de campeonato e time da rota, respondendo com erro quando
+// algum for inválido.
+func parseChampionshipAndTeam(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	championshipID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de campeonato inválido")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	teamID, err := uuid.Parse(c.Param("team_id"))
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de time inválido")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return championshipID, teamID, true
+}
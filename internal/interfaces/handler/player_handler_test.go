@@ -0,0 +1,145 @@
+package handler_test
+
+import (
+	"bytes"
+	"champi-maker/internal/application/service"
+	"champi-maker/internal/domain/entity"
+	"champi-maker/internal/infrastructure/repository"
+	"champi-maker/internal/interfaces/handler"
+
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlayerHandler_CreatePlayer_Success(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	ctx := context.Background()
+
+	userRepo := repository.NewUserRepositoryPg(pool)
+	teamRepo := repository.NewTeamRepositoryPg(pool)
+	playerRepo := repository.NewPlayerRepositoryPg(pool)
+
+	playerService := service.NewPlayerService(
+		playerRepo,
+		teamRepo,
+		repository.NewChampionshipRepositoryPg(pool),
+		repository.NewChampionshipTeamRepositoryPg(pool),
+		repository.NewChampionshipPlayerRepositoryPg(pool),
+	)
+	playerHandler := handler.NewPlayerHandler(playerService)
+
+	user := &entity.User{
+		ID:           uuid.New(),
+		Name:         "User",
+		Email:        "email@email.co",
+		PasswordHash: "password",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	err := userRepo.Create(ctx, user)
+	require.NoError(t, err)
+
+	team := &entity.Team{
+		ID:        uuid.New(),
+		Name:      "Team 1",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		UserID:    user.ID,
+	}
+	err = teamRepo.Create(ctx, team)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.POST("/teams/:id/players", playerHandler.CreatePlayer)
+	router.GET("/teams/:id/players", playerHandler.ListPlayersByTeam)
+
+	playerData := map[string]interface{}{
+		"name":         "Sócrates",
+		"shirt_number": 8,
+		"position":     entity.PlayerPositionMidfielder,
+	}
+	jsonBody, err := json.Marshal(playerData)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "/teams/"+team.ID.String()+"/players", bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	// A mesma camisa não pode ser usada por outro jogador do elenco
+	req, err = http.NewRequest(http.MethodPost, "/teams/"+team.ID.String()+"/players", bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	req, err = http.NewRequest(http.MethodGet, "/teams/"+team.ID.String()+"/players", nil)
+	require.NoError(t, err)
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var players []entity.Player
+	err = json.Unmarshal(recorder.Body.Bytes(), &players)
+	require.NoError(t, err)
+	require.Len(t, players, 1)
+	assert.Equal(t, "Sócrates", players[0].Name)
+}
+
+func TestPlayerHandler_CreatePlayer_InvalidData(t *testing.T) {
+	pool := setupTestDB(t)
+	defer pool.Close()
+	defer teardownTestDB(t, pool)
+
+	playerService := service.NewPlayerService(
+		repository.NewPlayerRepositoryPg(pool),
+		repository.NewTeamRepositoryPg(pool),
+		repository.NewChampionshipRepositoryPg(pool),
+		repository.NewChampionshipTeamRepositoryPg(pool),
+		repository.NewChampionshipPlayerRepositoryPg(pool),
+	)
+	playerHandler := handler.NewPlayerHandler(playerService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.POST("/teams/:id/players", playerHandler.CreatePlayer)
+
+	playerData := map[string]interface{}{
+		"name":         "Sócrates",
+		"shirt_number": 0,
+		"position":     "libero",
+	}
+	jsonBody, err := json.Marshal(playerData)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "/teams/"+uuid.New().String()+"/players", bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"champi-maker/internal/application/service"
+	"champi-maker/internal/domain/entity"
+	"champi-maker/pkg/web"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type PlayerStatisticsHandler struct {
+	playerStatisticsService service.PlayerStatisticsService
+}
+
+func NewPlayerStatisticsHandler(playerStatisticsService service.PlayerStatisticsService) *PlayerStatisticsHandler {
+	return &PlayerStatisticsHandler{
+		playerStatisticsService: playerStatisticsService,
+	}
+}
+
+// GetPlayerStatistics devolve a tabela de jogadores do campeonato. O parâmetro order_by escolhe
+// a tabela: goals (padrão), assists, cards, minutes ou clean_sheets.
+func (h *PlayerStatisticsHandler) GetPlayerStatistics(c *gin.Context) {
+	championshipIDParam := c.Param("id")
+	championshipID, err := uuid.Parse(championshipIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de campeonato inválido")
+		return
+	}
+
+	order := entity.PlayerStatisticsOrder(c.Query("order_by"))
+	statsList, err := h.playerStatisticsService.GetPlayerStatistics(c.Request.Context(), championshipID, order)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, statsList)
+}
+
+// RebuildPlayerStatistics recalcula os números dos jogadores a partir dos lances das partidas.
+func (h *PlayerStatisticsHandler) RebuildPlayerStatistics(c *gin.Context) {
+	championshipIDParam := c.Param("id")
+	championshipID, err := uuid.Parse(championshipIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de campeonato inválido")
+		return
+	}
+
+	if err := h.playerStatisticsService.RebuildPlayerStatistics(c.Request.Context(), championshipID); err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "Estatísticas dos jogadores recalculadas com sucesso"})
+}
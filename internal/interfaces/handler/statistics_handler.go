@@ -4,6 +4,7 @@ import (
 	"champi-maker/internal/application/service"
 	"champi-maker/pkg/web"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -62,3 +63,153 @@ func (h *StatisticsHandler) GetStatisticsByChampionship(c *gin.Context) {
 
 	web.RespondWithJSON(c, http.StatusOK, statsList)
 }
+
+func (h *StatisticsHandler) GetStatisticsByGroup(c *gin.Context) {
+	championshipIDParam := c.Param("id")
+	championshipID, err := uuid.Parse(championshipIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de campeonato inválido")
+		return
+	}
+
+	groupNumber, err := strconv.Atoi(c.Param("group"))
+	if err != nil || groupNumber < 1 {
+		web.RespondWithError(c, http.StatusBadRequest, "Número do grupo inválido")
+		return
+	}
+
+	statsList, err := h.statisticsService.GetStatisticsByGroup(c.Request.Context(), championshipID, groupNumber)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, statsList)
+}
+
+// GetStandings devolve a classificação calculada diretamente das partidas do campeonato.
+func (h *StatisticsHandler) GetStandings(c *gin.Context) {
+	championshipIDParam := c.Param("id")
+	championshipID, err := uuid.Parse(championshipIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de campeonato inválido")
+		return
+	}
+
+	standings, err := h.statisticsService.ComputeStandings(c.Request.Context(), championshipID)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, standings)
+}
+
+// RebuildStatistics recalcula as estatísticas do campeonato a partir das partidas concluídas.
+func (h *StatisticsHandler) RebuildStatistics(c *gin.Context) {
+	championshipIDParam := c.Param("id")
+	championshipID, err := uuid.Parse(championshipIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de campeonato inválido")
+		return
+	}
+
+	if err := h.statisticsService.RebuildStatistics(c.Request.Context(), championshipID); err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, gin.H{"message": "Estatísticas recalculadas com sucesso"})
+}
+
+// GetCupStatistics devolve os números dos times no mata-mata do campeonato.
+func (h *StatisticsHandler) GetCupStatistics(c *gin.Context) {
+	championshipIDParam := c.Param("id")
+	championshipID, err := uuid.Parse(championshipIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de campeonato inválido")
+		return
+	}
+
+	statsList, err := h.statisticsService.GetCupStatistics(c.Request.Context(), championshipID)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, statsList)
+}
+
+// GetBracketPath devolve o caminho do time pela chave do mata-mata.
+func (h *StatisticsHandler) GetBracketPath(c *gin.Context) {
+	championshipID, teamID, ok := parseChampionshipAndTeam(c)
+	if !ok {
+		return
+	}
+
+	path, err := h.statisticsService.GetBracketPath(c.Request.Context(), championshipID, teamID)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, path)
+}
+
+// GetStandingsHistory devolve a classificação do campeonato ao fim de cada rodada.
+func (h *StatisticsHandler) GetStandingsHistory(c *gin.Context) {
+	championshipIDParam := c.Param("id")
+	championshipID, err := uuid.Parse(championshipIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de campeonato inválido")
+		return
+	}
+
+	tables, err := h.statisticsService.GetStandingsHistory(c.Request.Context(), championshipID)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, tables)
+}
+
+// GetStandingsAtRound devolve a classificação como estava ao fim da rodada.
+func (h *StatisticsHandler) GetStandingsAtRound(c *gin.Context) {
+	championshipIDParam := c.Param("id")
+	championshipID, err := uuid.Parse(championshipIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de campeonato inválido")
+		return
+	}
+
+	round, err := strconv.Atoi(c.Param("round"))
+	if err != nil || round < 1 {
+		web.RespondWithError(c, http.StatusBadRequest, "Número da rodada inválido")
+		return
+	}
+
+	table, err := h.statisticsService.GetStandingsAtRound(c.Request.Context(), championshipID, round)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, table)
+}
+
+// GetTeamPositions devolve a posição do time na classificação ao fim de cada rodada.
+func (h *StatisticsHandler) GetTeamPositions(c *gin.Context) {
+	championshipID, teamID, ok := parseChampionshipAndTeam(c)
+	if !ok {
+		return
+	}
+
+	positions, err := h.statisticsService.GetTeamPositions(c.Request.Context(), championshipID, teamID)
+	if err != nil {
+		web.RespondWithError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, positions)
+}
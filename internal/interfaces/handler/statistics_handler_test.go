@@ -29,8 +29,9 @@ func TestStatisticsHandler_GenerateInitialStatistics_Success(t *testing.T) {
 	championshipRepo := repository.NewChampionshipRepositoryPg(pool)
 	teamRepo := repository.NewTeamRepositoryPg(pool)
 	userRepo := repository.NewUserRepositoryPg(pool)
+	matchRepo := repository.NewMatchRepositoryPg(pool)
 
-	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo)
+	statisticsService := service.NewStatisticsService(statisticsRepo, championshipRepo, teamRepo, matchRepo, repository.NewCupStatisticsRepositoryPg(pool), repository.NewStandingsSnapshotRepositoryPg(pool))
 	statisticsHandler := handler.NewStatisticsHandler(statisticsService)
 
 	ctx := context.Background()
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"champi-maker/internal/application/service"
+	"champi-maker/pkg/web"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SuspensionHandler struct {
+	suspensionService service.SuspensionService
+}
+
+func NewSuspensionHandler(suspensionService service.SuspensionService) *SuspensionHandler {
+	return &SuspensionHandler{
+		suspensionService: suspensionService,
+	}
+}
+
+// ListSuspensions devolve as suspensões do campeonato. Com active=true, só as que ainda não
+// foram cumpridas.
+func (h *SuspensionHandler) ListSuspensions(c *gin.Context) {
+	championshipIDParam := c.Param("id")
+	championshipID, err := uuid.Parse(championshipIDParam)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, "ID de campeonato inválido")
+		return
+	}
+
+	activeOnly := c.Query("active") == "true"
+	suspensions, err := h.suspensionService.ListSuspensions(c.Request.Context(), championshipID, activeOnly)
+	if err != nil {
+		web.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	web.RespondWithJSON(c, http.StatusOK, suspensions)
+}
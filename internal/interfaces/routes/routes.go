@@ -14,6 +14,11 @@ func RegisterRoutes(
 	championshipHandler *handler.ChampionshipHandler,
 	matchHandler *handler.MatchHandler,
 	statisticsHandler *handler.StatisticsHandler,
+	matchEventHandler *handler.MatchEventHandler,
+	playerHandler *handler.PlayerHandler,
+	playerStatisticsHandler *handler.PlayerStatisticsHandler,
+	suspensionHandler *handler.SuspensionHandler,
+	lineupHandler *handler.LineupHandler,
 ) {
 	router.POST("/users/register", userHandler.Register)
 	router.POST("/users/login", userHandler.Login)
@@ -36,17 +41,61 @@ func RegisterRoutes(
 		api.GET("/teams", teamHandler.ListTeams)
 		api.GET("/users/:user_id/teams", teamHandler.ListTeamsByUserID)
 
+		api.POST("/teams/:id/players", playerHandler.CreatePlayer)
+		api.GET("/teams/:id/players", playerHandler.ListPlayersByTeam)
+		api.GET("/players/:id", playerHandler.GetPlayerByID)
+		api.PUT("/players/:id", playerHandler.UpdatePlayer)
+		api.DELETE("/players/:id", playerHandler.DeletePlayer)
+		api.GET("/championships/:id/teams/:team_id/players", playerHandler.ListSquad)
+		api.POST("/championships/:id/teams/:team_id/players", playerHandler.RegisterPlayer)
+		api.DELETE("/championships/:id/teams/:team_id/players/:player_id", playerHandler.UnregisterPlayer)
+
 		api.POST("/championships", championshipHandler.CreateChampionship)
 		api.GET("/championships/:id", championshipHandler.GetChampionshipByID)
 		api.PUT("/championships/:id", championshipHandler.UpdateChampionship)
 		api.DELETE("/championships/:id", championshipHandler.DeleteChampionship)
 		api.GET("/championships", championshipHandler.ListChampionships)
+		api.GET("/championships/:id/teams", championshipHandler.ListTeams)
+		api.POST("/championships/:id/teams", championshipHandler.EnrollTeam)
+		api.DELETE("/championships/:id/teams/:team_id", championshipHandler.WithdrawTeam)
+		api.POST("/championships/:id/registration", championshipHandler.OpenRegistration)
+		api.POST("/championships/:id/start", championshipHandler.StartChampionship)
+		api.POST("/championships/:id/cancel", championshipHandler.CancelChampionship)
 
 		api.GET("/matches/:id", matchHandler.GetMatchByID)
 		api.PUT("/matches/:id/result", matchHandler.UpdateMatchResult)
-		api.GET("/championships/:championship_id/matches", matchHandler.ListMatchesByChampionship)
+		api.PUT("/matches/:id/result/correction", matchHandler.CorrectMatchResult)
+		api.POST("/matches/:id/start", matchHandler.StartMatch)
+		api.PUT("/matches/:id/score", matchHandler.UpdateLiveScore)
+		api.POST("/matches/:id/finish", matchHandler.FinishMatch)
+		api.POST("/matches/:id/postpone", matchHandler.PostponeMatch)
+		api.POST("/matches/:id/abandon", matchHandler.AbandonMatch)
+		api.POST("/matches/:id/forfeit", matchHandler.AwardForfeit)
+		api.GET("/matches/:id/events", matchEventHandler.ListMatchEvents)
+		api.POST("/matches/:id/events", matchEventHandler.CreateMatchEvent)
+		api.DELETE("/matches/:id/events/:event_id", matchEventHandler.DeleteMatchEvent)
+		api.GET("/matches/:id/lineups", lineupHandler.ListLineups)
+		api.GET("/matches/:id/lineups/:team_id", lineupHandler.GetLineup)
+		api.PUT("/matches/:id/lineups/:team_id", lineupHandler.SubmitLineup)
+		api.DELETE("/matches/:id/lineups/:team_id", lineupHandler.DeleteLineup)
+		api.GET("/matches/:id/sheet", lineupHandler.GetMatchSheet)
+		api.GET("/championships/:id/matches", matchHandler.ListMatchesByChampionship)
+		api.GET("/championships/:id/rounds/:round/matches", matchHandler.ListMatchesByRound)
+		api.POST("/championships/:id/rounds/next", matchHandler.GenerateNextRound)
+		api.POST("/championships/:id/teams/:team_id/disqualify", matchHandler.DisqualifyTeam)
 
 		api.POST("/championships/:id/statistics", statisticsHandler.GenerateInitialStatistics)
 		api.GET("/championships/:id/statistics", statisticsHandler.GetStatisticsByChampionship)
+		api.GET("/championships/:id/groups/:group/statistics", statisticsHandler.GetStatisticsByGroup)
+		api.GET("/championships/:id/standings", statisticsHandler.GetStandings)
+		api.GET("/championships/:id/standings/history", statisticsHandler.GetStandingsHistory)
+		api.GET("/championships/:id/rounds/:round/standings", statisticsHandler.GetStandingsAtRound)
+		api.GET("/championships/:id/teams/:team_id/positions", statisticsHandler.GetTeamPositions)
+		api.POST("/championships/:id/statistics/rebuild", statisticsHandler.RebuildStatistics)
+		api.GET("/championships/:id/statistics/cup", statisticsHandler.GetCupStatistics)
+		api.GET("/championships/:id/teams/:team_id/path", statisticsHandler.GetBracketPath)
+		api.GET("/championships/:id/statistics/players", playerStatisticsHandler.GetPlayerStatistics)
+		api.POST("/championships/:id/statistics/players/rebuild", playerStatisticsHandler.RebuildPlayerStatistics)
+		api.GET("/championships/:id/suspensions", suspensionHandler.ListSuspensions)
 	}
 }